@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+data "unifi_admins" "example" {}
+
+output "admin_emails" {
+  value = [for admin in data.unifi_admins.example.admins : admin.email]
+}
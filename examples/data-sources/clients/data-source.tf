@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+data "unifi_clients" "example" {
+  device_mac = "00:27:22:00:00:05"
+}
+
+output "phones" {
+  value = {
+    for client in data.unifi_clients.example.clients : client.switch_port => client.hostname
+    if client.wired
+  }
+}
@@ -19,4 +19,11 @@ data "unifi_device" "example" {
 
 output "example" {
   value = data.unifi_device.example
-}
\ No newline at end of file
+}
+
+check "example_health" {
+  assert {
+    condition     = !data.unifi_device.example.upgradable
+    error_message = "${data.unifi_device.example.name} is running outdated firmware ${data.unifi_device.example.firmware_version}."
+  }
+}
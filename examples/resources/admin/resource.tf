@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+variable "oncall_password" {
+  type      = string
+  sensitive = true
+}
+
+# Invited admins are emailed a link to set up their account.
+resource "unifi_admin" "network_engineer" {
+  name  = "Jane Doe"
+  email = "jane.doe@example.com"
+  role  = "admin"
+  sites = ["default", "branch"]
+
+  device_adopt_enabled   = true
+  device_restart_enabled = true
+}
+
+resource "unifi_admin" "oncall" {
+  name                  = "On-call"
+  email                 = "oncall@example.com"
+  password              = var.oncall_password
+  requires_new_password = true
+  role                  = "readonly"
+}
@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_ap_group" "office" {
+  name = "Office"
+  device_macs = [
+    "00:11:22:33:44:01",
+    "00:11:22:33:44:02",
+  ]
+}
+
+# Only broadcast the staff WLAN from the office access points.
+resource "unifi_wlan" "staff" {
+  name         = "Staff"
+  security     = "wpa2"
+  passphrase   = var.staff_passphrase
+  network_id   = var.staff_network_id
+  ap_group_ids = [unifi_ap_group.office.id]
+}
+
+variable "staff_network_id" {
+  type = string
+}
+
+variable "staff_passphrase" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_client" "printer" {
+  mac              = "00:11:22:33:44:55"
+  name             = "Office Printer"
+  note             = "2nd floor, next to the kitchen"
+  network_id       = "66a5357b30079358c34fe5d9"
+  fixed_ip         = "192.168.1.50"
+  local_dns_record = "printer.office.lan"
+}
+
+resource "unifi_client" "old_laptop" {
+  mac               = "66:77:88:99:aa:bb"
+  name              = "Old laptop"
+  blocked           = true
+  forget_on_destroy = true
+}
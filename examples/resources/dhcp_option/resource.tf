@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_network" "voice" {
+  name    = "Voice"
+  subnet  = "10.0.60.1/24"
+  vlan_id = 60
+}
+
+# Options 43, 66 and 67 are managed by the controller. PXE boot is configured with the boot_server, boot_filename and
+# tftp_server attributes of dhcp_server on unifi_network instead.
+resource "unifi_dhcp_option" "tftp_servers" {
+  name = "tftp-servers"
+  code = 150
+  type = "ipaddress"
+
+  network_values = {
+    (unifi_network.voice.id) = "10.0.60.10"
+  }
+}
+
+resource "unifi_dhcp_option" "provisioning_url" {
+  name = "provisioning-url"
+  code = 160
+  type = "text"
+
+  network_values = {
+    (unifi_network.voice.id) = "https://provisioning.example.com/phones"
+  }
+}
+
+resource "unifi_dhcp_option" "vlan" {
+  name  = "voice-vlan"
+  code  = 132
+  type  = "integer"
+  width = 16
+
+  network_values = {
+    (unifi_network.voice.id) = "60"
+  }
+}
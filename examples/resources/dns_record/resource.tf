@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_dns_record" "nas" {
+  name  = "nas.home.example.com"
+  type  = "A"
+  value = "192.168.1.10"
+  ttl   = 3600
+}
+
+resource "unifi_dns_record" "nas_v6" {
+  name  = "nas.home.example.com"
+  type  = "AAAA"
+  value = "2001:db8::10"
+}
+
+resource "unifi_dns_record" "files" {
+  name  = "files.home.example.com"
+  type  = "CNAME"
+  value = unifi_dns_record.nas.name
+}
+
+resource "unifi_dns_record" "mail" {
+  name     = "home.example.com"
+  type     = "MX"
+  value    = "mail.home.example.com"
+  priority = 10
+}
+
+resource "unifi_dns_record" "sip" {
+  name     = "_sip._udp.home.example.com"
+  type     = "SRV"
+  value    = "pbx.home.example.com"
+  port     = 5060
+  priority = 10
+  weight   = 5
+}
+
+resource "unifi_dns_record" "verification" {
+  name  = "home.example.com"
+  type  = "TXT"
+  value = "v=spf1 mx -all"
+}
@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_dynamic_dns" "vpn" {
+  service  = "cloudflare"
+  hostname = "vpn.example.com"
+  login    = "example.com"
+  password = var.cloudflare_api_token
+}
+
+# Publish the address of the backup WAN under a separate name.
+resource "unifi_dynamic_dns" "vpn_backup" {
+  service       = "dyndns"
+  hostname      = "vpn-backup.example.com"
+  login         = "example"
+  password      = var.dyndns_password
+  wan_interface = "wan2"
+}
+
+variable "cloudflare_api_token" {
+  type      = string
+  sensitive = true
+}
+
+variable "dyndns_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_firewall_group" "servers" {
+  name    = "Servers"
+  type    = "address-group"
+  members = ["192.168.1.10", "192.168.1.20-192.168.1.29", "10.10.0.0/24"]
+}
+
+resource "unifi_firewall_group" "servers_v6" {
+  name    = "Servers (IPv6)"
+  type    = "ipv6-address-group"
+  members = ["2001:db8::10", "2001:db8:1::/64"]
+}
+
+resource "unifi_firewall_group" "web" {
+  name    = "Web"
+  type    = "port-group"
+  members = ["80", "443", "8000-8080"]
+}
@@ -0,0 +1,54 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+variable "internal_zone_id" {
+  type = string
+}
+
+resource "unifi_firewall_zone" "iot" {
+  name = "IoT"
+}
+
+resource "unifi_firewall_policy" "block_iot_to_internal" {
+  name   = "Block IoT to Internal"
+  action = "BLOCK"
+
+  source = {
+    zone_id = unifi_firewall_zone.iot.id
+  }
+
+  destination = {
+    zone_id = var.internal_zone_id
+  }
+}
+
+resource "unifi_firewall_policy" "allow_home_assistant" {
+  name                 = "Allow Home Assistant"
+  action               = "ALLOW"
+  protocol             = "tcp"
+  create_allow_respond = true
+  index                = 10000
+
+  source = {
+    zone_id = var.internal_zone_id
+  }
+
+  destination = {
+    zone_id         = unifi_firewall_zone.iot.id
+    matching_target = "IP"
+    ips             = ["192.168.30.10"]
+    port            = "8123"
+  }
+}
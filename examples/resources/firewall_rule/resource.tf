@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_firewall_rule" "block_iot_to_lan" {
+  name       = "Block IoT to LAN"
+  ruleset    = "LAN_IN"
+  rule_index = 2001
+  action     = "drop"
+  logging    = true
+
+  source = {
+    network_id = "66a5357b30079358c34fe5d9"
+  }
+
+  destination = {
+    network_id = "66a5357b30079358c34fe5da"
+  }
+}
+
+resource "unifi_firewall_rule" "allow_established" {
+  name       = "Allow established IoT traffic"
+  ruleset    = "LAN_IN"
+  rule_index = 2000
+  action     = "accept"
+  states     = ["established", "related"]
+
+  destination = {
+    network_id = "66a5357b30079358c34fe5d9"
+  }
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+variable "iot_network_id" {
+  type = string
+}
+
+resource "unifi_firewall_zone" "iot" {
+  name        = "IoT"
+  network_ids = [var.iot_network_id]
+}
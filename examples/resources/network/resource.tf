@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_network" "example" {
+  name        = "Example"
+  subnet      = "10.0.10.1/24"
+  vlan_id     = 10
+  domain_name = "example.internal"
+
+  igmp_snooping     = true
+  network_isolation = true
+
+  dhcp_server = {
+    start       = "10.0.10.100"
+    stop        = "10.0.10.200"
+    lease_time  = 3600
+    dns_servers = ["1.1.1.1", "1.0.0.1"]
+  }
+}
+
+resource "unifi_network" "vlan_only" {
+  name    = "Routed Elsewhere"
+  purpose = "vlan-only"
+  vlan_id = 20
+}
@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_network_wan" "fibre" {
+  name          = "Fibre"
+  network_group = "WAN"
+  type          = "pppoe"
+  vlan_id       = 101
+
+  pppoe = {
+    username = "customer@isp.example"
+    password = "secret"
+  }
+
+  dns_servers = ["1.1.1.1", "1.0.0.1"]
+
+  smart_queue = {
+    download_rate = 900000
+    upload_rate   = 100000
+  }
+}
+
+resource "unifi_network_wan" "lte" {
+  name              = "LTE Backup"
+  network_group     = "WAN2"
+  type              = "static"
+  load_balance_type = "failover-only"
+
+  static_ip_settings = {
+    ip      = "192.0.2.10"
+    netmask = "255.255.255.0"
+    gateway = "192.0.2.1"
+  }
+}
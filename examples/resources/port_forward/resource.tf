@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_port_forward" "https" {
+  name          = "Web server"
+  external_port = "443"
+  forward_ip    = "192.168.1.10"
+  protocol      = "tcp"
+}
+
+resource "unifi_firewall_group" "office" {
+  name    = "Office"
+  type    = "address-group"
+  members = ["203.0.113.0/24"]
+}
+
+resource "unifi_port_forward" "rdp" {
+  name                     = "Remote desktop"
+  wan_interface            = "wan2"
+  external_port            = "13389-13390"
+  forward_ip               = "192.168.1.20"
+  forward_port             = "3389-3390"
+  protocol                 = "tcp"
+  logging                  = true
+  source_firewall_group_id = unifi_firewall_group.office.id
+}
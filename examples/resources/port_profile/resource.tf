@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_port_profile" "desk" {
+  name              = "Desk"
+  native_network_id = "66a5357b30079358c34fe5d9"
+  voice_network_id  = "66a5357b30079358c34fe5da"
+
+  tagged_vlan_management = "block_all"
+  poe_mode               = "auto"
+  isolation              = true
+
+  storm_control = {
+    type      = "level"
+    broadcast = 10
+    multicast = 20
+  }
+}
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_setting_radius" "default" {
+  secret = var.radius_secret
+}
+
+resource "unifi_radius_account" "printer" {
+  username = "printer"
+  password = var.printer_password
+  vlan_id  = 20
+}
+
+variable "radius_secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "printer_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_radius_profile" "corporate" {
+  name = "Corporate"
+
+  auth_servers = [
+    {
+      ip     = "192.168.1.10"
+      secret = var.radius_secret
+    },
+  ]
+
+  accounting_servers = [
+    {
+      ip     = "192.168.1.10"
+      secret = var.radius_secret
+    },
+  ]
+  interim_update_interval = 3600
+
+  # Place clients in the VLAN returned by the server.
+  vlan_enabled   = true
+  wlan_vlan_mode = "optional"
+}
+
+variable "radius_secret" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,54 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_network" "lan" {
+  name    = "LAN"
+  subnet  = "10.0.10.1/24"
+  vlan_id = 10
+}
+
+resource "unifi_network" "iot" {
+  name    = "IoT"
+  subnet  = "10.0.20.1/24"
+  vlan_id = 20
+}
+
+resource "unifi_network" "tv" {
+  name    = "TV"
+  subnet  = "10.0.30.1/24"
+  vlan_id = 30
+}
+
+resource "unifi_setting_gateway" "default" {
+  # Let consoles on the LAN open ports, but nothing else.
+  upnp_enabled     = true
+  upnp_network_ids = [unifi_network.lan.id]
+
+  # Make the IoT devices discoverable from the LAN.
+  mdns_enabled     = true
+  mdns_network_ids = [unifi_network.lan.id, unifi_network.iot.id]
+
+  # Proxy the ISP's IPTV multicast to the TV network.
+  igmp_proxy_upstream_network_id    = var.wan_network_id
+  igmp_proxy_downstream_network_ids = [unifi_network.tv.id]
+
+  sip_alg_enabled = false
+  mss_clamp       = "custom"
+  mss_clamp_mss   = 1452
+}
+
+variable "wan_network_id" {
+  type = string
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_setting_mgmt" "default" {
+  # Upgrade devices overnight.
+  auto_upgrade      = true
+  auto_upgrade_hour = 3
+
+  ssh_enabled               = true
+  ssh_auth_password_enabled = false
+  ssh_username              = "recovery"
+  ssh_password              = var.device_ssh_password
+  ssh_keys = [
+    {
+      name = "ops"
+      key  = trimspace(file("~/.ssh/id_ed25519.pub"))
+    },
+  ]
+}
+
+variable "device_ssh_password" {
+  type      = string
+  sensitive = true
+}
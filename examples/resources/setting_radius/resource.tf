@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_setting_radius" "default" {
+  secret = var.radius_secret
+
+  accounting_enabled      = true
+  interim_update_interval = 3600
+}
+
+variable "radius_secret" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,65 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+variable "office_pre_shared_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "warehouse_shared_secret_key" {
+  type      = string
+  sensitive = true
+}
+
+# An IPsec tunnel to a third party gateway.
+resource "unifi_site_vpn" "office" {
+  name = "Office"
+  type = "ipsec"
+
+  remote_subnets = ["10.1.0.0/24"]
+
+  ipsec = {
+    peer_ip        = "203.0.113.10"
+    pre_shared_key = var.office_pre_shared_key
+    ike_version    = "ikev2"
+    ike_dh_group   = 19
+    esp_dh_group   = 19
+  }
+}
+
+# An OpenVPN tunnel using a static key.
+resource "unifi_site_vpn" "warehouse" {
+  name = "Warehouse"
+  type = "openvpn"
+
+  remote_subnets = ["10.2.0.0/24"]
+
+  openvpn = {
+    remote_host       = "warehouse.example.com"
+    local_tunnel_ip   = "10.255.0.1"
+    remote_tunnel_ip  = "10.255.0.2"
+    shared_secret_key = var.warehouse_shared_secret_key
+  }
+}
+
+# A tunnel the controller sets up to another of its sites.
+resource "unifi_site_vpn" "branch" {
+  name = "Branch"
+  type = "auto"
+
+  auto = {
+    remote_site = "branch"
+  }
+}
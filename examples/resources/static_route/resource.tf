@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_static_route" "lab" {
+  name        = "Lab"
+  type        = "nexthop-route"
+  destination = "10.20.0.0/16"
+  next_hop    = "192.168.1.2"
+}
+
+resource "unifi_static_route" "backup_wan" {
+  name        = "Backup WAN"
+  type        = "interface-route"
+  destination = "198.51.100.0/24"
+  interface   = "WAN2"
+  distance    = 10
+}
+
+resource "unifi_static_route" "bogons" {
+  name        = "Drop bogons"
+  type        = "blackhole"
+  destination = "100.64.0.0/10"
+}
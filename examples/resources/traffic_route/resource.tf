@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+variable "vpn_client_network_id" {
+  type = string
+}
+
+resource "unifi_traffic_route" "streaming" {
+  description     = "Streaming through VPN"
+  network_id      = var.vpn_client_network_id
+  matching_target = "REGION"
+  regions         = ["GB"]
+
+  kill_switch_enabled = true
+}
+
+resource "unifi_traffic_route" "office" {
+  description     = "Office subnet through VPN"
+  network_id      = var.vpn_client_network_id
+  matching_target = "IP"
+  ip_addresses    = ["198.51.100.0/24"]
+}
@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+variable "kids_network_id" {
+  type = string
+}
+
+resource "unifi_traffic_rule" "block_social" {
+  description     = "Block social media"
+  action          = "BLOCK"
+  matching_target = "DOMAIN"
+  domains         = ["facebook.com", "instagram.com", "tiktok.com"]
+
+  target_network_ids = [var.kids_network_id]
+
+  schedule = {
+    days       = ["mon", "tue", "wed", "thu", "fri"]
+    start_time = "21:00"
+    end_time   = "23:59"
+  }
+}
+
+resource "unifi_traffic_rule" "guest_limit" {
+  description     = "Limit guest speed"
+  action          = "SPEED_LIMIT"
+  matching_target = "INTERNET"
+
+  target_client_macs = ["00:00:5e:00:53:01"]
+
+  bandwidth_limit = {
+    download_kbps = 20000
+    upload_kbps   = 5000
+  }
+}
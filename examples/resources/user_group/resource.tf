@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_user_group" "guests" {
+  name               = "Guests"
+  download_rate_kbps = 20000
+  upload_rate_kbps   = 5000
+}
+
+# Only limit downloads, uploads are unlimited.
+resource "unifi_user_group" "cameras" {
+  name               = "Cameras"
+  download_rate_kbps = 1000
+}
+
+resource "unifi_client" "doorbell" {
+  mac           = "00:11:22:33:44:55"
+  name          = "Doorbell"
+  user_group_id = unifi_user_group.cameras.id
+}
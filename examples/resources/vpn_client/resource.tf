@@ -0,0 +1,61 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+variable "wireguard_private_key" {
+  type      = string
+  sensitive = true
+}
+
+variable "openvpn_password" {
+  type      = string
+  sensitive = true
+}
+
+# A WireGuard tunnel configured from the settings supplied by the VPN provider.
+resource "unifi_vpn_client" "wireguard" {
+  name = "WireGuard VPN"
+  type = "wireguard"
+
+  wireguard = {
+    address         = "10.64.0.2/32"
+    peer_host       = "vpn.example.com"
+    peer_public_key = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+    private_key     = var.wireguard_private_key
+  }
+}
+
+# An OpenVPN tunnel configured from a configuration file.
+resource "unifi_vpn_client" "openvpn" {
+  name = "OpenVPN"
+  type = "openvpn"
+
+  openvpn = {
+    configuration = file("${path.module}/client.ovpn")
+    username      = "user"
+    password      = var.openvpn_password
+  }
+}
+
+# Send traffic to a region through the WireGuard tunnel.
+resource "unifi_traffic_route" "streaming" {
+  description     = "Streaming through VPN"
+  network_id      = unifi_vpn_client.wireguard.id
+  matching_target = "REGION"
+  regions         = ["GB"]
+}
+
+output "wireguard_status" {
+  value = unifi_vpn_client.wireguard.status
+}
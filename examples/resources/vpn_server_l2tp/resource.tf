@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+variable "l2tp_pre_shared_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "unifi_vpn_server_l2tp" "remote_access" {
+  name           = "Remote Access"
+  pre_shared_key = var.l2tp_pre_shared_key
+  subnet         = "192.168.5.1/24"
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_vpn_server_openvpn" "remote_access" {
+  name            = "Remote Access"
+  client_endpoint = "vpn.example.com"
+  subnet          = "192.168.4.1/24"
+  port            = 443
+  protocol        = "TCP"
+}
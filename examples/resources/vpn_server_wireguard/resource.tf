@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_vpn_server_wireguard" "remote_access" {
+  name            = "Remote Access"
+  client_endpoint = "vpn.example.com"
+  subnet          = "192.168.3.1/24"
+  dns_servers     = ["192.168.1.1"]
+
+  peers = {
+    # The key pair is generated, and the private key included in the client configuration.
+    "jane-laptop" = {
+      interface_ip = "192.168.3.2"
+    }
+
+    # The key pair was generated on the device, so only the public key is needed.
+    "john-phone" = {
+      interface_ip = "192.168.3.3"
+      public_key   = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+    }
+  }
+}
+
+# Read with `terraform output -raw jane_laptop_wireguard_config > jane-laptop.conf`.
+output "jane_laptop_wireguard_config" {
+  value     = unifi_vpn_server_wireguard.remote_access.peers["jane-laptop"].client_configuration
+  sensitive = true
+}
@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "jamestoyer/unifi"
+    }
+  }
+}
+
+provider "unifi" {
+  insecure = true
+  url      = "https://127.0.0.1:8443"
+  username = "admin"
+  password = "admin"
+}
+
+resource "unifi_network" "iot" {
+  name    = "IoT"
+  subnet  = "10.0.30.1/24"
+  vlan_id = 30
+
+  dhcp_server = {
+    start = "10.0.30.10"
+    stop  = "10.0.30.250"
+  }
+}
+
+resource "unifi_wlan" "iot" {
+  name       = "Example IoT"
+  security   = "wpa2"
+  passphrase = "correct-horse-battery-staple"
+  network_id = unifi_network.iot.id
+
+  bands            = ["2g"]
+  hide_ssid        = true
+  client_isolation = true
+
+  minimum_data_rates = {
+    rate_2g_kbps = 6000
+  }
+
+  mac_filter = {
+    policy        = "allow"
+    mac_addresses = ["00:27:22:00:00:10", "00:27:22:00:00:11"]
+  }
+
+  schedule = [
+    {
+      days             = ["mon", "tue", "wed", "thu", "fri"]
+      start_hour       = 7
+      duration_minutes = 720
+    },
+  ]
+}
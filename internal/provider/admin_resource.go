@@ -0,0 +1,496 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+	"slices"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &AdminResource{}
+	_ resource.ResourceWithImportState    = &AdminResource{}
+	_ resource.ResourceWithValidateConfig = &AdminResource{}
+
+	defaultAdminResourceModel = AdminResourceModel{}
+
+	emailRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+)
+
+func NewAdminResource() resource.Resource {
+	return &AdminResource{}
+}
+
+// AdminResource defines the resource implementation.
+type AdminResource struct {
+	client *unifiClient
+}
+
+func (r *AdminResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin"
+}
+
+func (r *AdminResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultAdminResourceModel.schema()
+}
+
+func (r *AdminResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AdminResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AdminResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+func (r *AdminResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AdminResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sites, diags := data.sites(ctx, r.client.site)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cmd := adminCommand{
+		Email:               data.Email.ValueString(),
+		Name:                data.Name.ValueString(),
+		Password:            data.Password.ValueString(),
+		RequiresNewPassword: data.RequiresNewPassword.ValueBool(),
+	}
+	cmd.setRole(data.Role.ValueString(), data.permissions())
+
+	// The admin is created on the first site, then granted access to the rest.
+	a, err := r.client.createAdmin(ctx, sites[0], cmd)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create admin, got error: %s", err))
+		return
+	}
+
+	for _, site := range sites[1:] {
+		err = r.client.grantAdmin(ctx, site, *a.ID, data.Role.ValueString(), data.permissions())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant admin access to site %q, got error: %s", site, err))
+			return
+		}
+	}
+
+	data, diags = newAdminResourceModel(ctx, a, sites, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Admin created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdminResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AdminResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a, sites, err := r.readAdmin(ctx, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read admin, got error: %s", err))
+		return
+	}
+
+	data, diags := newAdminResourceModel(ctx, a, sites, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdminResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AdminResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sites, diags := data.sites(ctx, r.client.site)
+	resp.Diagnostics.Append(diags...)
+
+	currentSites, diags := state.sites(ctx, r.client.site)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Granting access to a site the admin can already access updates their role on it. Sites are granted before any are
+	// revoked so the controller never sees the admin without a site.
+	for _, site := range sites {
+		err := r.client.grantAdmin(ctx, site, data.ID.ValueString(), data.Role.ValueString(), data.permissions())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant admin access to site %q, got error: %s", site, err))
+			return
+		}
+	}
+
+	for _, site := range currentSites {
+		if slices.Contains(sites, site) {
+			continue
+		}
+
+		err := r.client.revokeAdmin(ctx, site, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke admin access to site %q, got error: %s", site, err))
+			return
+		}
+	}
+
+	a, sites, err := r.readAdmin(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read admin, got error: %s", err))
+		return
+	}
+
+	data, diags = newAdminResourceModel(ctx, a, sites, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdminResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AdminResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sites, diags := data.sites(ctx, r.client.site)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Revoking access to the last site deletes the admin.
+	for _, site := range sites {
+		err := r.client.revokeAdmin(ctx, site, data.ID.ValueString())
+		var notFoundError *unifi.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete admin, got error: %s", err))
+			return
+		}
+	}
+}
+
+func (r *AdminResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readAdmin finds the admin on every site, returning it along with the names of the sites it can access. Admins are
+// looked up on all sites, rather than those in the state, so access granted outside Terraform shows as a change.
+func (r *AdminResource) readAdmin(ctx context.Context, id string) (*admin, []string, error) {
+	allSites, err := r.client.ListSites(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var found *admin
+	var sites []string
+	for _, site := range allSites {
+		a, err := r.client.getAdmin(ctx, site.Name, id)
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if found == nil {
+			found = a
+		}
+
+		sites = append(sites, site.Name)
+	}
+
+	if found == nil {
+		return nil, nil, &unifi.NotFoundError{}
+	}
+
+	return found, sites, nil
+}
+
+type AdminResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	DeviceAdoptEnabled   types.Bool   `tfsdk:"device_adopt_enabled"`
+	DeviceRestartEnabled types.Bool   `tfsdk:"device_restart_enabled"`
+	Email                types.String `tfsdk:"email"`
+	Name                 types.String `tfsdk:"name"`
+	Password             types.String `tfsdk:"password"`
+	RequiresNewPassword  types.Bool   `tfsdk:"requires_new_password"`
+	Role                 types.String `tfsdk:"role"`
+	Sites                types.Set    `tfsdk:"sites"`
+}
+
+func (m *AdminResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A controller admin. Admins with a `password` are created as local admins, otherwise " +
+			"an invite is emailed to them.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi admin identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"device_adopt_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, the admin can adopt devices. Not valid for `readonly` admins.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"device_restart_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, the admin can restart devices. Not valid for `readonly` admins.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the admin. Invites are sent to this address.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emailRegexp, "must be an email address"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the admin. Local admins log in with this name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of a local admin. When not set an invite is emailed to the admin " +
+					"instead. Changing the password recreates the admin.",
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"requires_new_password": schema.BoolAttribute{
+				MarkdownDescription: "When true, a local admin has to change their password when they first log in. " +
+					"Requires `password`.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role of the admin on its sites. One of `super_admin`, `admin` or `readonly`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(adminRoleAdmin, adminRoleReadOnly, adminRoleSuperAdmin),
+				},
+			},
+			"sites": schema.SetAttribute{
+				MarkdownDescription: "The names of the sites the admin can access, e.g. `default`. Defaults to the " +
+					"site set in the provider. Can't be set for `super_admin` admins, which can access every site.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// validate checks for combinations the controller rejects, so they are reported at plan time with an explanation.
+func (m *AdminResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The controller lists super admins on every site, so any other set of sites would never match.
+	if m.Role.ValueString() == adminRoleSuperAdmin && !m.Sites.IsNull() && !m.Sites.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("sites"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("sites can't be set for %s admins, which can access every site.", adminRoleSuperAdmin),
+		)
+	}
+
+	if m.Role.ValueString() != adminRoleReadOnly {
+		return diags
+	}
+
+	for name, enabled := range map[string]types.Bool{
+		"device_adopt_enabled":   m.DeviceAdoptEnabled,
+		"device_restart_enabled": m.DeviceRestartEnabled,
+	} {
+		if !enabled.ValueBool() {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s can't be enabled for %s admins.", name, adminRoleReadOnly),
+		)
+	}
+
+	return diags
+}
+
+// sites returns the sorted names of the sites the admin can access, defaulting to the given site.
+func (m *AdminResourceModel) sites(ctx context.Context, site string) ([]string, diag.Diagnostics) {
+	var sites []string
+	diags := utils.SetValueStrings(ctx, m.Sites, &sites)
+
+	if len(sites) == 0 {
+		sites = []string{site}
+	}
+
+	slices.Sort(sites)
+	return sites, diags
+}
+
+// permissions returns the device permissions of the admin.
+func (m *AdminResourceModel) permissions() []string {
+	var permissions []string
+	if m.DeviceAdoptEnabled.ValueBool() {
+		permissions = append(permissions, adminPermissionDeviceAdopt)
+	}
+
+	if m.DeviceRestartEnabled.ValueBool() {
+		permissions = append(permissions, adminPermissionDeviceRestart)
+	}
+
+	return permissions
+}
+
+func newAdminResourceModel(ctx context.Context, a *admin, sites []string, model AdminResourceModel) (AdminResourceModel, diag.Diagnostics) {
+	// Computed values
+	model.ID = types.StringPointerValue(a.ID)
+
+	// Configurable Values
+	model.DeviceAdoptEnabled = types.BoolValue(slices.Contains(a.Permissions, adminPermissionDeviceAdopt))
+	model.DeviceRestartEnabled = types.BoolValue(slices.Contains(a.Permissions, adminPermissionDeviceRestart))
+	model.Email = types.StringPointerValue(a.Email)
+	model.Name = types.StringPointerValue(a.Name)
+	model.Role = types.StringValue(a.role())
+
+	// The password isn't returned, and whether a new one is required changes once the admin has logged in.
+	if model.RequiresNewPassword.IsNull() || model.RequiresNewPassword.IsUnknown() {
+		model.RequiresNewPassword = types.BoolValue(a.RequiresNewPassword)
+	}
+
+	var diags diag.Diagnostics
+	model.Sites, diags = types.SetValueFrom(ctx, types.StringType, sites)
+
+	return model, diags
+}
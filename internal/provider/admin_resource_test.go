@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccAdminResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAdminConfig("readonly", `device_adopt_enabled = true`),
+				ExpectError: regexp.MustCompile(`device_adopt_enabled can't be enabled for readonly admins`),
+			},
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_admin" "test" {
+  name                  = "Test Admin"
+  email                 = "test-admin@example.com"
+  role                  = "admin"
+  requires_new_password = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccAdminConfig("super_admin", `sites = ["default"]`),
+				ExpectError: regexp.MustCompile(`sites can't be set for super_admin admins`),
+			},
+		},
+	})
+}
+
+func TestAccAdminResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAdminConfig("readonly", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_admin.test", "name", "Test Admin"),
+					resource.TestCheckResourceAttr("unifi_admin.test", "role", "readonly"),
+					resource.TestCheckResourceAttr("unifi_admin.test", "sites.#", "1"),
+					resource.TestCheckResourceAttr("unifi_admin.test", "sites.0", "default"),
+					resource.TestCheckResourceAttr("unifi_admin.test", "device_adopt_enabled", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "unifi_admin.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "requires_new_password"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccAdminConfig("admin", `
+  device_adopt_enabled   = true
+  device_restart_enabled = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_admin.test", "role", "admin"),
+					resource.TestCheckResourceAttr("unifi_admin.test", "device_adopt_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_admin.test", "device_restart_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAdminConfig(role, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_admin" "test" {
+  name     = "Test Admin"
+  email    = "test-admin@example.com"
+  password = "not-a-real-password-1"
+  role     = %q
+  %s
+}
+`, role, settings)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"slices"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AdminsDataSource{}
+
+func NewAdminsDataSource() datasource.DataSource {
+	return &AdminsDataSource{}
+}
+
+// AdminsDataSource defines the data source implementation.
+type AdminsDataSource struct {
+	client *unifiClient
+}
+
+// AdminsDataSourceModel describes the data source data model.
+type AdminsDataSourceModel struct {
+	Site types.String `tfsdk:"site"`
+
+	// Read Only
+	Admins []AdminDataSourceModel `tfsdk:"admins"`
+}
+
+type AdminDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	DeviceAdoptEnabled   types.Bool   `tfsdk:"device_adopt_enabled"`
+	DeviceRestartEnabled types.Bool   `tfsdk:"device_restart_enabled"`
+	Email                types.String `tfsdk:"email"`
+	Name                 types.String `tfsdk:"name"`
+	Role                 types.String `tfsdk:"role"`
+}
+
+func (d *AdminsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admins"
+}
+
+func (d *AdminsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the admins that can access a Unifi site",
+
+		Attributes: map[string]schema.Attribute{
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site to list admins for. When set this overrides the default provider site",
+				Computed:            true,
+				Optional:            true,
+			},
+
+			// Read only
+			"admins": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Admin identifier",
+							Computed:            true,
+						},
+						"device_adopt_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the admin can adopt devices.",
+							Computed:            true,
+						},
+						"device_restart_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the admin can restart devices.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of the admin on the site. One of `super_admin`, `admin` or " +
+								"`readonly`.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AdminsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AdminsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AdminsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := data.Site.ValueString()
+	if site == "" {
+		site = d.client.site
+	}
+
+	data.Site = types.StringValue(site)
+
+	admins, err := d.client.listAdmin(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list admins, got error: %s", err))
+		return
+	}
+
+	data.Admins = make([]AdminDataSourceModel, 0, len(admins))
+	for _, a := range admins {
+		data.Admins = append(data.Admins, AdminDataSourceModel{
+			ID:                   types.StringPointerValue(a.ID),
+			DeviceAdoptEnabled:   types.BoolValue(slices.Contains(a.Permissions, adminPermissionDeviceAdopt)),
+			DeviceRestartEnabled: types.BoolValue(slices.Contains(a.Permissions, adminPermissionDeviceRestart)),
+			Email:                types.StringPointerValue(a.Email),
+			Name:                 types.StringPointerValue(a.Name),
+			Role:                 types.StringValue(a.role()),
+		})
+	}
+
+	tflog.Trace(ctx, "admins read", map[string]interface{}{"site": site, "count": len(data.Admins)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccAdminsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unifi_admins.test", "site", "default"),
+					// The admin the provider logs in as is always listed.
+					resource.TestCheckResourceAttrSet("data.unifi_admins.test", "admins.0.id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAdminsDataSourceConfig = `
+provider "unifi" {}
+data "unifi_admins" "test" {}
+`
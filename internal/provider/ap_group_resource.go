@@ -0,0 +1,366 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customtype"
+	"strings"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &APGroupResource{}
+	_ resource.ResourceWithImportState = &APGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &APGroupResource{}
+
+	defaultAPGroupResourceModel = APGroupResourceModel{}
+)
+
+func NewAPGroupResource() resource.Resource {
+	return &APGroupResource{}
+}
+
+// APGroupResource defines the resource implementation.
+type APGroupResource struct {
+	client *unifiClient
+}
+
+func (r *APGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ap_group"
+}
+
+func (r *APGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultAPGroupResourceModel.schema()
+}
+
+func (r *APGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks each device in the group is an access point adopted on the site. The controller accepts any MAC,
+// leaving a group that silently doesn't broadcast from the missing access points.
+func (r *APGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or when the provider hasn't been configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan APGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.DeviceMACs.IsUnknown() {
+		return
+	}
+
+	site := r.client.site
+	if plan.Site.ValueString() != "" {
+		site = plan.Site.ValueString()
+	}
+
+	devices, err := r.client.ListDevice(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list devices, got error: %s", err))
+		return
+	}
+
+	devicesByMAC := make(map[string]unifi.Device, len(devices))
+	for _, device := range devices {
+		if device.MAC != nil {
+			devicesByMAC[strings.ToLower(*device.MAC)] = device
+		}
+	}
+
+	var missing, notAPs []string
+	for _, element := range plan.DeviceMACs.Elements() {
+		mac, ok := element.(customtype.Mac)
+		if !ok || mac.IsUnknown() || mac.IsNull() {
+			continue
+		}
+
+		device, ok := devicesByMAC[strings.ToLower(mac.ValueString())]
+		switch {
+		case !ok || !device.Adopted:
+			missing = append(missing, mac.ValueString())
+		case device.Type == nil || *device.Type != "uap":
+			notAPs = append(notAPs, mac.ValueString())
+		}
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("device_macs"),
+			"Access Point Not Adopted",
+			fmt.Sprintf("The devices %s are not adopted on the site %q. Adopt them before adding them to an AP group.",
+				strings.Join(missing, ", "), site),
+		)
+	}
+
+	if len(notAPs) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("device_macs"),
+			"Device Not An Access Point",
+			fmt.Sprintf("The devices %s are not access points. Only access points can be added to an AP group.",
+				strings.Join(notAPs, ", ")),
+		)
+	}
+}
+
+func (r *APGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	group := &apGroup{}
+	resp.Diagnostics.Append(data.toAPGroup(ctx, group)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.createAPGroup(ctx, site, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create AP group, got error: %s", err))
+		return
+	}
+
+	data, diags := newAPGroupResourceModel(ctx, group, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "AP group created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	group, err := r.client.getAPGroup(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AP group, got error: %s", err))
+		return
+	}
+
+	data, diags := newAPGroupResourceModel(ctx, group, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data APGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current group so settings that aren't managed by the resource are left untouched.
+	group, err := r.client.getAPGroup(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AP group, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toAPGroup(ctx, group)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err = r.client.updateAPGroup(ctx, site, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update AP group, got error: %s", err))
+		return
+	}
+
+	data, diags := newAPGroupResourceModel(ctx, group, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.deleteAPGroup(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete AP group, got error: %s", err))
+		return
+	}
+}
+
+func (r *APGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type APGroupResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	DeviceMACs types.Set    `tfsdk:"device_macs"`
+	Name       types.String `tfsdk:"name"`
+	Site       types.String `tfsdk:"site"`
+}
+
+func (m *APGroupResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A group of access points. WLANs assigned to the group with `ap_group_ids` are only " +
+			"broadcast from the access points in it.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi AP group identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"device_macs": schema.SetAttribute{
+				MarkdownDescription: "The MAC addresses, in lower case, of the access points in the group. Each must " +
+					"be adopted on the site.",
+				ElementType: customtype.MacType{},
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the AP group belongs to. Setting this overrides the default site set " +
+					"in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (m *APGroupResourceModel) toAPGroup(ctx context.Context, group *apGroup) diag.Diagnostics {
+	var macs []string
+	diags := m.DeviceMACs.ElementsAs(ctx, &macs, false)
+
+	group.DeviceMACs = macs
+	group.Name = m.Name.ValueStringPointer()
+
+	return diags
+}
+
+func newAPGroupResourceModel(ctx context.Context, group *apGroup, site string, model APGroupResourceModel) (APGroupResourceModel, diag.Diagnostics) {
+	// Computed values
+	model.ID = types.StringPointerValue(group.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.Name = types.StringPointerValue(group.Name)
+
+	var diags diag.Diagnostics
+	model.DeviceMACs, diags = types.SetValueFrom(ctx, customtype.MacType{}, group.DeviceMACs)
+
+	return model, diags
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAccAPGroupResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAPGroupConfig(`"not-a-mac"`),
+				ExpectError: regexp.MustCompile(`Invalid Mac String Value`),
+			},
+			{
+				Config:      testAccAPGroupConfig(`"00:00:5e:00:53:01"`),
+				ExpectError: regexp.MustCompile(`Access Point Not Adopted`),
+			},
+		},
+	})
+}
+
+func TestAccAPGroupResource_Simple(t *testing.T) {
+	device := getAccessPointDevice(context.Background(), t)
+	mac := fmt.Sprintf("%q", strings.ToLower(*device.MAC))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAPGroupConfig(mac),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_ap_group.test", "name", "Test Group"),
+					resource.TestCheckResourceAttr("unifi_ap_group.test", "device_macs.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unifi_ap_group.test", "device_macs.*", strings.ToLower(*device.MAC)),
+					resource.TestCheckResourceAttrSet("unifi_ap_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_ap_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccAPGroupConfigWithName("Updated Test Group", mac),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_ap_group.test", "name", "Updated Test Group"),
+					resource.TestCheckResourceAttr("unifi_ap_group.test", "device_macs.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPGroupConfig(macs string) string {
+	return testAccAPGroupConfigWithName("Test Group", macs)
+}
+
+func testAccAPGroupConfigWithName(name, macs string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_ap_group" "test" {
+  name        = %q
+  device_macs = [%s]
+}
+`, name, macs)
+}
@@ -0,0 +1,222 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+const (
+	clientAPIPath      = "/api"
+	clientAPIPathNew   = "/proxy/network/api"
+	clientV2APIPath    = "/v2/api"
+	clientV2APIPathNew = "/proxy/network/v2/api"
+
+	// clientV2Prefix marks a relative URL as belonging to the v2 API, e.g. `v2/site/default/apgroups`.
+	clientV2Prefix = "v2/"
+)
+
+// SetBaseURL sets the base URL of the controller on both the SDK client and the unifiClient.
+func (c *unifiClient) SetBaseURL(base string) error {
+	if err := c.Client.SetBaseURL(base); err != nil {
+		return err
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		return err
+	}
+
+	c.baseURL = u
+	return nil
+}
+
+// setAPIPaths works out whether the controller uses the UniFi OS style API paths. This mirrors the check the SDK
+// performs on login, which isn't exposed.
+// TODO: Expose the API paths from the unifi client
+func (c *unifiClient) setAPIPaths(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	// Checking the return code on the first request so don't follow a redirect.
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Transport: c.httpClient.Transport,
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	c.apiPath, c.v2APIPath = clientAPIPath, clientV2APIPath
+	if resp.StatusCode == http.StatusOK {
+		c.apiPath, c.v2APIPath = clientAPIPathNew, clientV2APIPathNew
+	}
+
+	return nil
+}
+
+// apiPaths returns the API and v2 API paths of the controller, working them out on first use. Only this is locked so
+// requests can run in parallel.
+func (c *unifiClient) apiPaths(ctx context.Context) (string, string, error) {
+	c.apiPathLock.Lock()
+	defer c.apiPathLock.Unlock()
+
+	if c.apiPath == "" {
+		if err := c.setAPIPaths(ctx); err != nil {
+			return "", "", err
+		}
+	}
+
+	return c.apiPath, c.v2APIPath, nil
+}
+
+// do performs a request against the controller for endpoints that are not yet supported by the SDK. Relative URLs are
+// resolved against the API path of the controller, or the v2 API path when they start with clientV2Prefix. Errors are
+// returned in the same form as the SDK so they can be handled in the same way.
+// TODO: Move these endpoints in to the unifi client
+func (c *unifiClient) do(ctx context.Context, method, relativeURL string, reqBody interface{}, respBody interface{}) error {
+	apiPath, v2APIPath, err := c.apiPaths(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to determine API URL style: %w", err)
+	}
+
+	var reqReader io.Reader
+	if reqBody != nil {
+		reqBytes, err := json.Marshal(reqBody)
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %s %s %w", method, relativeURL, err)
+		}
+
+		reqReader = bytes.NewReader(reqBytes)
+	}
+
+	reqURL, err := url.Parse(relativeURL)
+	if err != nil {
+		return fmt.Errorf("unable to parse URL: %s %s %w", method, relativeURL, err)
+	}
+
+	if !strings.HasPrefix(relativeURL, "/") && !reqURL.IsAbs() {
+		if strings.HasPrefix(reqURL.Path, clientV2Prefix) {
+			reqURL.Path = path.Join(v2APIPath, strings.TrimPrefix(reqURL.Path, clientV2Prefix))
+		} else {
+			reqURL.Path = path.Join(apiPath, reqURL.Path)
+		}
+	}
+
+	u := c.baseURL.ResolveReference(reqURL)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqReader)
+	if err != nil {
+		return fmt.Errorf("unable to create request: %s %s %w", method, relativeURL, err)
+	}
+
+	req.Header.Set("User-Agent", "terraform-provider-unifi/0.1")
+	req.Header.Add("Content-Type", "application/json; charset=utf-8")
+
+	if csrf := c.CSRFToken(); csrf != "" {
+		req.Header.Set("X-CSRF-Token", csrf)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to perform request: %s %s %w", method, relativeURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &unifi.NotFoundError{}
+	}
+
+	// The v2 API responds with 201 and 204 as well.
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w (%s) for %s %s", decodeAPIError(resp.Body), resp.Status, method, u.String())
+	}
+
+	if respBody == nil || resp.ContentLength == 0 {
+		return nil
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(respBody); err != nil {
+		return fmt.Errorf("unable to decode body: %s %s %w", method, relativeURL, err)
+	}
+
+	return nil
+}
+
+// clientMeta is the metadata returned by the controller with every v1 API response.
+type clientMeta struct {
+	RC              string                 `json:"rc"`
+	Message         string                 `json:"msg"`
+	ValidationError *clientValidationError `json:"validationError,omitempty"`
+}
+
+// clientValidationError is returned alongside `api.err.Invalid` and identifies the field that was rejected.
+type clientValidationError struct {
+	Field   string `json:"field"`
+	Pattern string `json:"pattern"`
+}
+
+// decodeAPIError converts an error response body in to an *unifi.APIError. The `api.err.*` message is kept so that it
+// can be surfaced to users, along with the field the controller rejected when it is given.
+func decodeAPIError(body io.Reader) error {
+	errBody := struct {
+		Meta clientMeta `json:"meta"`
+		Data []struct {
+			Meta clientMeta `json:"meta"`
+		} `json:"data"`
+
+		// The v2 API returns the error at the top level instead.
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{}
+	if err := json.NewDecoder(body).Decode(&errBody); err != nil {
+		return err
+	}
+
+	if errBody.Meta.RC == "" && errBody.Code != "" {
+		message := errBody.Code
+		if errBody.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, errBody.Message)
+		}
+
+		return &unifi.APIError{
+			RC:      "error",
+			Message: message,
+		}
+	}
+
+	meta := errBody.Meta
+	if len(errBody.Data) > 0 && errBody.Data[0].Meta.RC == "error" {
+		meta = errBody.Data[0].Meta
+	}
+
+	message := meta.Message
+	if v := meta.ValidationError; v != nil && v.Field != "" {
+		message = fmt.Sprintf("%s: invalid value for %s", message, v.Field)
+		if v.Pattern != "" {
+			message = fmt.Sprintf("%s, must match %s", message, v.Pattern)
+		}
+	}
+
+	return &unifi.APIError{
+		RC:      meta.RC,
+		Message: message,
+	}
+}
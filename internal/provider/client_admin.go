@@ -0,0 +1,146 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"strings"
+)
+
+// Admins are managed through site manager commands, which the SDK doesn't support. An admin exists once, and is granted
+// a role on each site they can access.
+
+const (
+	adminPermissionDeviceAdopt   = "API_DEVICE_ADOPT"
+	adminPermissionDeviceRestart = "API_DEVICE_RESTART"
+
+	adminRoleAdmin      = "admin"
+	adminRoleReadOnly   = "readonly"
+	adminRoleSuperAdmin = "super_admin"
+)
+
+type admin struct {
+	ID                  *string  `json:"_id,omitempty"`
+	Email               *string  `json:"email,omitempty"`
+	IsSuper             bool     `json:"is_super"`
+	Name                *string  `json:"name,omitempty"`
+	Permissions         []string `json:"permissions"`
+	RequiresNewPassword bool     `json:"requires_new_password"`
+	Role                *string  `json:"role,omitempty"`
+}
+
+// role returns the role of the admin as used by the provider. Super admins are site admins with is_super set.
+func (a admin) role() string {
+	if a.IsSuper {
+		return adminRoleSuperAdmin
+	}
+
+	if a.Role == nil {
+		return ""
+	}
+
+	return *a.Role
+}
+
+// adminCommand is the body of the site manager commands used to manage admins.
+type adminCommand struct {
+	Cmd                 string   `json:"cmd"`
+	Admin               string   `json:"admin,omitempty"`
+	Email               string   `json:"email,omitempty"`
+	IsSuper             bool     `json:"is_super,omitempty"`
+	Name                string   `json:"name,omitempty"`
+	Password            string   `json:"x_password,omitempty"`
+	Permissions         []string `json:"permissions,omitempty"`
+	RequiresNewPassword bool     `json:"requires_new_password,omitempty"`
+	Role                string   `json:"role,omitempty"`
+}
+
+// setRole sets the role fields of the command from a provider role.
+func (cmd *adminCommand) setRole(role string, permissions []string) {
+	cmd.Role, cmd.IsSuper = role, false
+	if role == adminRoleSuperAdmin {
+		cmd.Role, cmd.IsSuper = adminRoleAdmin, true
+	}
+
+	cmd.Permissions = make([]string, 0, len(permissions))
+	cmd.Permissions = append(cmd.Permissions, permissions...)
+}
+
+func (c *unifiClient) adminCommand(ctx context.Context, site string, cmd adminCommand) error {
+	var respBody struct {
+		Meta clientMeta `json:"meta"`
+	}
+
+	return c.do(ctx, "POST", fmt.Sprintf("s/%s/cmd/sitemgr", site), cmd, &respBody)
+}
+
+func (c *unifiClient) listAdmin(ctx context.Context, site string) ([]admin, error) {
+	var respBody struct {
+		Meta clientMeta `json:"meta"`
+		Data []admin    `json:"data"`
+	}
+
+	err := c.do(ctx, "POST", fmt.Sprintf("s/%s/cmd/sitemgr", site), adminCommand{Cmd: "get-admins"}, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody.Data, nil
+}
+
+func (c *unifiClient) getAdmin(ctx context.Context, site, id string) (*admin, error) {
+	admins, err := c.listAdmin(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range admins {
+		if a.ID != nil && *a.ID == id {
+			return &a, nil
+		}
+	}
+
+	return nil, &unifi.NotFoundError{}
+}
+
+// createAdmin creates a local admin with a password when one is given, otherwise an invite is emailed to the admin.
+// The controller doesn't return the admin, so it's looked up by email afterwards.
+func (c *unifiClient) createAdmin(ctx context.Context, site string, cmd adminCommand) (*admin, error) {
+	cmd.Cmd = "invite-admin"
+	if cmd.Password != "" {
+		cmd.Cmd = "create-admin"
+	}
+
+	if err := c.adminCommand(ctx, site, cmd); err != nil {
+		return nil, err
+	}
+
+	admins, err := c.listAdmin(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range admins {
+		if a.Email != nil && strings.EqualFold(*a.Email, cmd.Email) {
+			return &a, nil
+		}
+	}
+
+	return nil, &unifi.NotFoundError{}
+}
+
+// grantAdmin gives an existing admin access to a site, or updates the role they have on it.
+func (c *unifiClient) grantAdmin(ctx context.Context, site, id, role string, permissions []string) error {
+	cmd := adminCommand{Cmd: "grant-admin", Admin: id}
+	cmd.setRole(role, permissions)
+
+	return c.adminCommand(ctx, site, cmd)
+}
+
+// revokeAdmin removes an admin's access to a site. The controller deletes admins that can no longer access any site.
+func (c *unifiClient) revokeAdmin(ctx context.Context, site, id string) error {
+	return c.adminCommand(ctx, site, adminCommand{Cmd: "revoke-admin", Admin: id})
+}
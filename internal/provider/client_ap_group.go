@@ -0,0 +1,71 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+)
+
+// apGroup is a group of access points that WLANs can be broadcast from. AP groups are only available through the v2
+// API, which the SDK doesn't support.
+type apGroup struct {
+	ID         *string  `json:"_id,omitempty"`
+	DeviceMACs []string `json:"device_macs"`
+	Name       *string  `json:"name,omitempty"`
+	NoDelete   *bool    `json:"attr_no_delete,omitempty"`
+}
+
+func (c *unifiClient) listAPGroup(ctx context.Context, site string) ([]apGroup, error) {
+	var respBody []apGroup
+
+	err := c.do(ctx, "GET", fmt.Sprintf("v2/site/%s/apgroups", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+func (c *unifiClient) getAPGroup(ctx context.Context, site, id string) (*apGroup, error) {
+	groups, err := c.listAPGroup(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		if group.ID != nil && *group.ID == id {
+			return &group, nil
+		}
+	}
+
+	return nil, &unifi.NotFoundError{}
+}
+
+func (c *unifiClient) createAPGroup(ctx context.Context, site string, group *apGroup) (*apGroup, error) {
+	var respBody apGroup
+
+	err := c.do(ctx, "POST", fmt.Sprintf("v2/site/%s/apgroups", site), group, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) updateAPGroup(ctx context.Context, site string, group *apGroup) (*apGroup, error) {
+	var respBody apGroup
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("v2/site/%s/apgroups/%s", site, *group.ID), group, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) deleteAPGroup(ctx context.Context, site, id string) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("v2/site/%s/apgroups/%s", site, id), nil, nil)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"strconv"
+)
+
+// deviceStats holds the health and inventory details the controller reports for a device. These are not part of
+// unifi.Device as they are read only.
+type deviceStats struct {
+	IP                *string                  `json:"ip,omitempty"`
+	LastSeen          *int64                   `json:"last_seen,omitempty"`
+	Serial            *string                  `json:"serial,omitempty"`
+	SystemStats       *deviceSystemStats       `json:"system-stats,omitempty"`
+	Temperatures      []deviceTemperatureStats `json:"temperatures,omitempty"`
+	Upgradable        *bool                    `json:"upgradable,omitempty"`
+	UpgradeToFirmware *string                  `json:"upgrade_to_firmware,omitempty"`
+	Uplink            *deviceUplinkStats       `json:"uplink,omitempty"`
+	Uptime            *int64                   `json:"uptime,omitempty"`
+	Version           *string                  `json:"version,omitempty"`
+}
+
+type deviceSystemStats struct {
+	CPU *numberString `json:"cpu,omitempty"`
+	Mem *numberString `json:"mem,omitempty"`
+}
+
+type deviceTemperatureStats struct {
+	Name  *string  `json:"name,omitempty"`
+	Type  *string  `json:"type,omitempty"`
+	Value *float64 `json:"value,omitempty"`
+}
+
+type deviceUplinkStats struct {
+	UplinkMAC        *string `json:"uplink_mac,omitempty"`
+	UplinkRemotePort *int64  `json:"uplink_remote_port,omitempty"`
+}
+
+// numberString handles numbers the controller may return as either a JSON number or a string, e.g. `"12.5"`.
+type numberString float64
+
+func (n *numberString) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		var f float64
+		if err := json.Unmarshal(b, &f); err != nil {
+			return err
+		}
+
+		*n = numberString(f)
+		return nil
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+
+	*n = numberString(f)
+	return nil
+}
+
+// Float64Pointer returns the value as a *float64, or nil if the value is not set.
+func (n *numberString) Float64Pointer() *float64 {
+	if n == nil {
+		return nil
+	}
+
+	f := float64(*n)
+	return &f
+}
+
+func (c *unifiClient) getDeviceStats(ctx context.Context, site, mac string) (*deviceStats, error) {
+	var respBody struct {
+		Meta clientMeta    `json:"meta"`
+		Data []deviceStats `json:"data"`
+	}
+
+	err := c.do(ctx, "GET", fmt.Sprintf("s/%s/stat/device/%s", site, mac), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(respBody.Data) != 1 {
+		return nil, &unifi.NotFoundError{}
+	}
+
+	d := respBody.Data[0]
+	return &d, nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+)
+
+// The SDK only generates unexported functions for custom DHCP options, and doesn't include the values networks set for
+// them at all.
+
+func (c *unifiClient) getDHCPOption(ctx context.Context, site, id string) (*unifi.DHCPOption, error) {
+	var respBody struct {
+		Meta clientMeta         `json:"meta"`
+		Data []unifi.DHCPOption `json:"data"`
+	}
+
+	err := c.do(ctx, "GET", fmt.Sprintf("s/%s/rest/dhcpoption/%s", site, id), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(respBody.Data) != 1 {
+		return nil, &unifi.NotFoundError{}
+	}
+
+	o := respBody.Data[0]
+	return &o, nil
+}
+
+func (c *unifiClient) createDHCPOption(ctx context.Context, site string, option *unifi.DHCPOption) (*unifi.DHCPOption, error) {
+	var respBody struct {
+		Meta clientMeta         `json:"meta"`
+		Data []unifi.DHCPOption `json:"data"`
+	}
+
+	err := c.do(ctx, "POST", fmt.Sprintf("s/%s/rest/dhcpoption", site), option, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(respBody.Data) != 1 {
+		return nil, &unifi.NotFoundError{}
+	}
+
+	o := respBody.Data[0]
+	return &o, nil
+}
+
+func (c *unifiClient) updateDHCPOption(ctx context.Context, site string, option *unifi.DHCPOption) (*unifi.DHCPOption, error) {
+	var respBody struct {
+		Meta clientMeta         `json:"meta"`
+		Data []unifi.DHCPOption `json:"data"`
+	}
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("s/%s/rest/dhcpoption/%s", site, *option.ID), option, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(respBody.Data) != 1 {
+		return nil, &unifi.NotFoundError{}
+	}
+
+	o := respBody.Data[0]
+	return &o, nil
+}
+
+func (c *unifiClient) deleteDHCPOption(ctx context.Context, site, id string) error {
+	var respBody struct {
+		Meta clientMeta `json:"meta"`
+	}
+
+	return c.do(ctx, "DELETE", fmt.Sprintf("s/%s/rest/dhcpoption/%s", site, id), struct{}{}, &respBody)
+}
+
+// networkDHCPOptions holds the values a network sets for the custom DHCP options of its site.
+type networkDHCPOptions struct {
+	ID           *string                  `json:"_id,omitempty"`
+	DHCPDOptions []networkDHCPOptionValue `json:"dhcpd_options"`
+}
+
+type networkDHCPOptionValue struct {
+	OptionID string `json:"option_id"`
+	Value    string `json:"value"`
+}
+
+func (c *unifiClient) listNetworkDHCPOptions(ctx context.Context, site string) ([]networkDHCPOptions, error) {
+	var respBody struct {
+		Meta clientMeta           `json:"meta"`
+		Data []networkDHCPOptions `json:"data"`
+	}
+
+	err := c.do(ctx, "GET", fmt.Sprintf("s/%s/rest/networkconf", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody.Data, nil
+}
+
+// getDHCPOptionValues returns the value each network sets for a custom DHCP option, keyed by network ID.
+func (c *unifiClient) getDHCPOptionValues(ctx context.Context, site, optionID string) (map[string]string, error) {
+	networks, err := c.listNetworkDHCPOptions(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	for _, network := range networks {
+		for _, option := range network.DHCPDOptions {
+			if option.OptionID == optionID && network.ID != nil {
+				values[*network.ID] = option.Value
+			}
+		}
+	}
+
+	return values, nil
+}
+
+// setDHCPOptionValues sets the value of a custom DHCP option for each network in values, and removes it from every
+// other network. The values of other options are left as they are.
+func (c *unifiClient) setDHCPOptionValues(ctx context.Context, site, optionID string, values map[string]string) error {
+	c.dhcpOptionLock.Lock()
+	defer c.dhcpOptionLock.Unlock()
+
+	networks, err := c.listNetworkDHCPOptions(ctx, site)
+	if err != nil {
+		return err
+	}
+
+	// Check every network exists before changing any of them.
+	ids := make(map[string]bool, len(networks))
+	for _, network := range networks {
+		if network.ID != nil {
+			ids[*network.ID] = true
+		}
+	}
+
+	for networkID := range values {
+		if !ids[networkID] {
+			return fmt.Errorf("network %s not found", networkID)
+		}
+	}
+
+	for _, network := range networks {
+		if network.ID == nil {
+			continue
+		}
+
+		value, ok := values[*network.ID]
+
+		var existing string
+		var exists bool
+		options := make([]networkDHCPOptionValue, 0, len(network.DHCPDOptions)+1)
+		for _, option := range network.DHCPDOptions {
+			if option.OptionID == optionID {
+				existing, exists = option.Value, true
+				continue
+			}
+
+			options = append(options, option)
+		}
+
+		if ok == exists && value == existing {
+			continue
+		}
+
+		if ok {
+			options = append(options, networkDHCPOptionValue{OptionID: optionID, Value: value})
+		}
+
+		// Only the options are sent, which the controller merges in to the existing network.
+		reqBody := map[string][]networkDHCPOptionValue{"dhcpd_options": options}
+
+		var respBody struct {
+			Meta clientMeta `json:"meta"`
+		}
+
+		err = c.do(ctx, "PUT", fmt.Sprintf("s/%s/rest/networkconf/%s", site, *network.ID), reqBody, &respBody)
+		if err != nil {
+			return fmt.Errorf("unable to set DHCP option on network %s: %w", *network.ID, err)
+		}
+	}
+
+	return nil
+}
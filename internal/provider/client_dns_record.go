@@ -0,0 +1,77 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+)
+
+// Static DNS records are only available through the v2 API, which the SDK doesn't support.
+
+// dnsRecord is a static DNS record served by the gateway.
+type dnsRecord struct {
+	ID         *string `json:"_id,omitempty"`
+	Enabled    bool    `json:"enabled"`
+	Key        string  `json:"key"`
+	Port       *int64  `json:"port,omitempty"`
+	Priority   *int64  `json:"priority,omitempty"`
+	RecordType string  `json:"record_type"`
+	TTL        int64   `json:"ttl"`
+	Value      string  `json:"value"`
+	Weight     *int64  `json:"weight,omitempty"`
+}
+
+func (c *unifiClient) listDNSRecord(ctx context.Context, site string) ([]dnsRecord, error) {
+	var respBody []dnsRecord
+
+	err := c.do(ctx, "GET", fmt.Sprintf("v2/site/%s/static-dns", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+func (c *unifiClient) getDNSRecord(ctx context.Context, site, id string) (*dnsRecord, error) {
+	records, err := c.listDNSRecord(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if record.ID != nil && *record.ID == id {
+			return &record, nil
+		}
+	}
+
+	return nil, &unifi.NotFoundError{}
+}
+
+func (c *unifiClient) createDNSRecord(ctx context.Context, site string, record *dnsRecord) (*dnsRecord, error) {
+	var respBody dnsRecord
+
+	err := c.do(ctx, "POST", fmt.Sprintf("v2/site/%s/static-dns", site), record, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) updateDNSRecord(ctx context.Context, site string, record *dnsRecord) (*dnsRecord, error) {
+	var respBody dnsRecord
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("v2/site/%s/static-dns/%s", site, *record.ID), record, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) deleteDNSRecord(ctx context.Context, site, id string) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("v2/site/%s/static-dns/%s", site, id), nil, nil)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+)
+
+// firewallPolicy allows, blocks or rejects traffic from one firewall zone to another.
+type firewallPolicy struct {
+	ID                    *string                `json:"_id,omitempty"`
+	Action                string                 `json:"action"`
+	ConnectionStateType   string                 `json:"connection_state_type"`
+	ConnectionStates      []string               `json:"connection_states"`
+	CreateAllowRespond    bool                   `json:"create_allow_respond"`
+	Description           string                 `json:"description"`
+	Destination           firewallPolicyEndpoint `json:"destination"`
+	Enabled               bool                   `json:"enabled"`
+	ICMPTypeName          string                 `json:"icmp_typename"`
+	ICMPv6TypeName        string                 `json:"icmp_v6_typename"`
+	Index                 *int64                 `json:"index,omitempty"`
+	IPVersion             string                 `json:"ip_version"`
+	Logging               bool                   `json:"logging"`
+	MatchIPSec            bool                   `json:"match_ip_sec"`
+	MatchOppositeProtocol bool                   `json:"match_opposite_protocol"`
+	Name                  string                 `json:"name"`
+	Predefined            bool                   `json:"predefined"`
+	Protocol              string                 `json:"protocol"`
+
+	// The schedule has the same form as the one used by traffic rules.
+	Schedule trafficSchedule        `json:"schedule"`
+	Source   firewallPolicyEndpoint `json:"source"`
+}
+
+// firewallPolicyEndpoint is the source or destination of a firewallPolicy. Which of the matching fields is used depends
+// on MatchingTarget.
+type firewallPolicyEndpoint struct {
+	AppCategoryIDs     []int64  `json:"app_category_ids,omitempty"`
+	AppIDs             []int64  `json:"app_ids,omitempty"`
+	ClientMACs         []string `json:"client_macs,omitempty"`
+	IPs                []string `json:"ips,omitempty"`
+	MatchOppositeIPs   bool     `json:"match_opposite_ips"`
+	MatchOppositePorts bool     `json:"match_opposite_ports"`
+	MatchingTarget     string   `json:"matching_target"`
+	MatchingTargetType string   `json:"matching_target_type,omitempty"`
+	NetworkIDs         []string `json:"network_ids,omitempty"`
+	Port               string   `json:"port,omitempty"`
+	PortMatchingType   string   `json:"port_matching_type"`
+	Regions            []string `json:"regions,omitempty"`
+	WebDomains         []string `json:"web_domains,omitempty"`
+	ZoneID             string   `json:"zone_id"`
+}
+
+func (c *unifiClient) listFirewallPolicy(ctx context.Context, site string) ([]firewallPolicy, error) {
+	var respBody []firewallPolicy
+
+	err := c.do(ctx, "GET", fmt.Sprintf("v2/site/%s/firewall-policies", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+func (c *unifiClient) getFirewallPolicy(ctx context.Context, site, id string) (*firewallPolicy, error) {
+	policies, err := c.listFirewallPolicy(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, policy := range policies {
+		if policy.ID != nil && *policy.ID == id {
+			return &policy, nil
+		}
+	}
+
+	return nil, &unifi.NotFoundError{}
+}
+
+func (c *unifiClient) createFirewallPolicy(ctx context.Context, site string, policy *firewallPolicy) (*firewallPolicy, error) {
+	var respBody firewallPolicy
+
+	err := c.do(ctx, "POST", fmt.Sprintf("v2/site/%s/firewall-policies", site), policy, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) updateFirewallPolicy(ctx context.Context, site string, policy *firewallPolicy) (*firewallPolicy, error) {
+	var respBody firewallPolicy
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("v2/site/%s/firewall-policies/%s", site, *policy.ID), policy, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+// deleteFirewallPolicy deletes a policy. The controller only supports deleting policies in batches.
+func (c *unifiClient) deleteFirewallPolicy(ctx context.Context, site, id string) error {
+	return c.do(ctx, "POST", fmt.Sprintf("v2/site/%s/firewall-policies/batch-delete", site), []string{id}, nil)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+)
+
+// The SDK drops the validation details the controller returns when a firewall rule is rejected, which leaves users with
+// a bare `api.err.Invalid`. Creating and updating rules through unifiClient.do keeps them.
+
+func (c *unifiClient) createFirewallRule(ctx context.Context, site string, rule *unifi.FirewallRule) (*unifi.FirewallRule, error) {
+	var respBody struct {
+		Meta clientMeta           `json:"meta"`
+		Data []unifi.FirewallRule `json:"data"`
+	}
+
+	err := c.do(ctx, "POST", fmt.Sprintf("s/%s/rest/firewallrule", site), rule, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(respBody.Data) != 1 {
+		return nil, &unifi.NotFoundError{}
+	}
+
+	r := respBody.Data[0]
+	return &r, nil
+}
+
+func (c *unifiClient) updateFirewallRule(ctx context.Context, site string, rule *unifi.FirewallRule) (*unifi.FirewallRule, error) {
+	var respBody struct {
+		Meta clientMeta           `json:"meta"`
+		Data []unifi.FirewallRule `json:"data"`
+	}
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("s/%s/rest/firewallrule/%s", site, *rule.ID), rule, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(respBody.Data) != 1 {
+		return nil, &unifi.NotFoundError{}
+	}
+
+	r := respBody.Data[0]
+	return &r, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+)
+
+// Firewall zones and policies replace the legacy rule set based firewall from Network 9. They're only available through
+// the v2 API, which the SDK doesn't support.
+
+// firewallZone groups networks so firewall policies can be applied between them.
+type firewallZone struct {
+	ID          *string  `json:"_id,omitempty"`
+	DefaultZone bool     `json:"default_zone,omitempty"`
+	Name        string   `json:"name"`
+	NetworkIDs  []string `json:"network_ids"`
+	ZoneKey     string   `json:"zone_key,omitempty"`
+}
+
+func (c *unifiClient) listFirewallZone(ctx context.Context, site string) ([]firewallZone, error) {
+	var respBody []firewallZone
+
+	err := c.do(ctx, "GET", fmt.Sprintf("v2/site/%s/firewall/zone", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+func (c *unifiClient) getFirewallZone(ctx context.Context, site, id string) (*firewallZone, error) {
+	zones, err := c.listFirewallZone(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, zone := range zones {
+		if zone.ID != nil && *zone.ID == id {
+			return &zone, nil
+		}
+	}
+
+	return nil, &unifi.NotFoundError{}
+}
+
+func (c *unifiClient) createFirewallZone(ctx context.Context, site string, zone *firewallZone) (*firewallZone, error) {
+	var respBody firewallZone
+
+	err := c.do(ctx, "POST", fmt.Sprintf("v2/site/%s/firewall/zone", site), zone, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) updateFirewallZone(ctx context.Context, site string, zone *firewallZone) (*firewallZone, error) {
+	var respBody firewallZone
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("v2/site/%s/firewall/zone/%s", site, *zone.ID), zone, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) deleteFirewallZone(ctx context.Context, site, id string) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("v2/site/%s/firewall/zone/%s", site, id), nil, nil)
+}
+
+// zoneBasedFirewall returns true when the site uses zone-based firewalling. Sites that have been migrated always have
+// the built-in zones, while the endpoint is missing, or empty, on older controllers and sites that haven't been. The
+// result is cached as it only changes when a site is migrated.
+func (c *unifiClient) zoneBasedFirewall(ctx context.Context, site string) (bool, error) {
+	c.firewallLock.Lock()
+	defer c.firewallLock.Unlock()
+
+	if zoneBased, ok := c.zoneBasedFirewallSites[site]; ok {
+		return zoneBased, nil
+	}
+
+	zones, err := c.listFirewallZone(ctx, site)
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		return false, err
+	}
+
+	if c.zoneBasedFirewallSites == nil {
+		c.zoneBasedFirewallSites = make(map[string]bool)
+	}
+
+	c.zoneBasedFirewallSites[site] = len(zones) > 0
+	return len(zones) > 0, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+)
+
+// networkServices holds the per network flags for the gateway services configured by unifi_setting_gateway. Not all of
+// these are part of unifi.Network, and updating them through the SDK would round trip every other network setting.
+type networkServices struct {
+	ID                  *string `json:"_id,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	Purpose             *string `json:"purpose,omitempty"`
+	IGMPProxyDownstream bool    `json:"igmp_proxy_downstream"`
+	IGMPProxyUpstream   bool    `json:"igmp_proxy_upstream"`
+	MDNSEnabled         bool    `json:"mdns_enabled"`
+	UPnPLANEnabled      bool    `json:"upnp_lan_enabled"`
+}
+
+func (c *unifiClient) listNetworkServices(ctx context.Context, site string) ([]networkServices, error) {
+	var respBody struct {
+		Meta clientMeta        `json:"meta"`
+		Data []networkServices `json:"data"`
+	}
+
+	err := c.do(ctx, "GET", fmt.Sprintf("s/%s/rest/networkconf", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody.Data, nil
+}
+
+// updateNetworkServices sets the gateway service flags of a network. Only the flags are sent, which the controller
+// merges in to the existing network.
+func (c *unifiClient) updateNetworkServices(ctx context.Context, site string, network networkServices) error {
+	reqBody := map[string]bool{
+		"igmp_proxy_downstream": network.IGMPProxyDownstream,
+		"igmp_proxy_upstream":   network.IGMPProxyUpstream,
+		"mdns_enabled":          network.MDNSEnabled,
+		"upnp_lan_enabled":      network.UPnPLANEnabled,
+	}
+
+	var respBody struct {
+		Meta clientMeta `json:"meta"`
+	}
+
+	return c.do(ctx, "PUT", fmt.Sprintf("s/%s/rest/networkconf/%s", site, *network.ID), reqBody, &respBody)
+}
+
+// updateSettingGateway applies the gateway settings and the per network service flags of the model, returning the
+// updated settings and networks.
+func (c *unifiClient) updateSettingGateway(ctx context.Context, site string, model SettingGatewayResourceModel) (*unifi.SettingUsg, []networkServices, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Check the networks first, so a missing network doesn't leave the settings half applied.
+	networks, err := c.listNetworkServices(ctx, site)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networks, got error: %s", err))
+		return nil, nil, diags
+	}
+
+	changed, d := model.toNetworkServices(ctx, networks)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	setting, err := c.GetSettingUsg(ctx, site)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read gateway settings, got error: %s", err))
+		return nil, nil, diags
+	}
+
+	diags.Append(model.toUnifiSettingUsg(ctx, setting)...)
+
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	setting, err = c.UpdateSettingUsg(ctx, site, setting)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update gateway settings, got error: %s", err))
+		return nil, nil, diags
+	}
+
+	for _, network := range changed {
+		if err = c.updateNetworkServices(ctx, site, network); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update network %s, got error: %s", utils.StringValue(network.Name), err))
+			return nil, nil, diags
+		}
+	}
+
+	networks, err = c.listNetworkServices(ctx, site)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read networks, got error: %s", err))
+		return nil, nil, diags
+	}
+
+	return setting, networks, diags
+}
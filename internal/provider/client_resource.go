@@ -0,0 +1,470 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customtype"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"net"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &ClientResource{}
+	_ resource.ResourceWithImportState = &ClientResource{}
+
+	defaultClientResourceModel = ClientResourceModel{}
+)
+
+func NewClientResource() resource.Resource {
+	return &ClientResource{}
+}
+
+// ClientResource defines the resource implementation.
+type ClientResource struct {
+	client *unifiClient
+}
+
+func (r *ClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client"
+}
+
+func (r *ClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultClientResourceModel.schema()
+}
+
+func (r *ClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	if data.UserGroupID.IsUnknown() {
+		userGroupID, err := r.client.getDefaultUserGroupID(ctx, site)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the default user group, got error: %s", err))
+			return
+		}
+
+		data.UserGroupID = types.StringValue(userGroupID)
+	}
+
+	// The controller keeps a record of every client it has seen, so most of the time this is taking over an existing
+	// client. When it hasn't seen the client yet, e.g. a new device being set up before it's plugged in, the record is
+	// created ahead of time so the settings are in place when it first connects.
+	user, err := r.client.GetUserByMAC(ctx, site, data.MAC.ValueString())
+	var notFoundError *unifi.NotFoundError
+	switch {
+	case errors.As(err, &notFoundError):
+		user = &unifi.User{MAC: data.MAC.ValueStringPointer()}
+		data.toUnifiUser(user)
+
+		user, err = r.client.CreateUser(ctx, site, user)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+			return
+		}
+	case err != nil:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client, got error: %s", err))
+		return
+	default:
+		data.toUnifiUser(user)
+
+		user, err = r.client.UpdateUser(ctx, site, user)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update client, got error: %s", err))
+			return
+		}
+	}
+
+	if err := r.setBlocked(ctx, site, user, data.Blocked.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update client, got error: %s", err))
+		return
+	}
+
+	data = newClientResourceModel(user, site, data)
+
+	tflog.Trace(ctx, "Client created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	user, err := r.client.GetUser(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client, got error: %s", err))
+		return
+	}
+
+	data = newClientResourceModel(user, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current client so settings that aren't managed by the resource are left untouched.
+	user, err := r.client.GetUser(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client, got error: %s", err))
+		return
+	}
+
+	data.toUnifiUser(user)
+
+	user, err = r.client.UpdateUser(ctx, site, user)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update client, got error: %s", err))
+		return
+	}
+
+	if err := r.setBlocked(ctx, site, user, data.Blocked.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update client, got error: %s", err))
+		return
+	}
+
+	data = newClientResourceModel(user, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	var notFoundError *unifi.NotFoundError
+	if data.ForgetOnDestroy.ValueBool() {
+		err := r.client.DeleteUserByMAC(ctx, site, data.MAC.ValueString())
+		if err != nil && !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to forget client, got error: %s", err))
+		}
+
+		return
+	}
+
+	// Without forgetting the client it stays known to the controller, so remove the settings that were managed.
+	user, err := r.client.GetUser(ctx, site, data.ID.ValueString())
+	if errors.As(err, &notFoundError) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client, got error: %s", err))
+		return
+	}
+
+	defaultClientResourceModel.toUnifiUser(user)
+
+	user, err = r.client.UpdateUser(ctx, site, user)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update client, got error: %s", err))
+		return
+	}
+
+	if err := r.setBlocked(ctx, site, user, false); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update client, got error: %s", err))
+		return
+	}
+}
+
+// ImportState imports a client by either its ID or MAC address.
+func (r *ClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := net.ParseMAC(req.ID); err != nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	user, err := r.client.GetUserByMAC(ctx, r.client.site, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read client, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), user.ID)...)
+}
+
+// setBlocked blocks or unblocks the client. The controller ignores changes to blocked made with the rest of the client
+// settings, so it's set with a separate command.
+func (r *ClientResource) setBlocked(ctx context.Context, site string, user *unifi.User, blocked bool) error {
+	if (user.Blocked != nil && *user.Blocked) == blocked {
+		return nil
+	}
+
+	var err error
+	if blocked {
+		err = r.client.BlockUserByMAC(ctx, site, *user.MAC)
+	} else {
+		err = r.client.UnblockUserByMAC(ctx, site, *user.MAC)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	user.Blocked = &blocked
+	return nil
+}
+
+type ClientResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	Blocked         types.Bool          `tfsdk:"blocked"`
+	FixedIP         iptypes.IPv4Address `tfsdk:"fixed_ip"`
+	ForgetOnDestroy types.Bool          `tfsdk:"forget_on_destroy"`
+	LocalDNSRecord  types.String        `tfsdk:"local_dns_record"`
+	MAC             customtype.Mac      `tfsdk:"mac"`
+	Name            types.String        `tfsdk:"name"`
+	NetworkID       types.String        `tfsdk:"network_id"`
+	Note            types.String        `tfsdk:"note"`
+	Site            types.String        `tfsdk:"site"`
+	UserGroupID     types.String        `tfsdk:"user_group_id"`
+}
+
+func (m *ClientResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi client, i.e. a device that connects to the network. Clients the controller has " +
+			"not seen yet are created so their settings apply as soon as they connect.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi client identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"blocked": schema.BoolAttribute{
+				MarkdownDescription: "When true, the client is blocked from connecting to the network.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"fixed_ip": schema.StringAttribute{
+				MarkdownDescription: "A fixed IPv4 address to hand out to the client with DHCP.",
+				CustomType:          iptypes.IPv4AddressType{},
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("network_id")),
+				},
+			},
+			"forget_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "When true, the controller forgets the client, including its history, when the " +
+					"resource is destroyed. Otherwise only the settings managed by the resource are removed.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"local_dns_record": schema.StringAttribute{
+				MarkdownDescription: "A host name the gateway resolves to the client's fixed IP.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("fixed_ip")),
+				},
+			},
+			"mac": schema.StringAttribute{
+				MarkdownDescription: "The MAC address of the client.",
+				CustomType:          customtype.MacType{},
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The alias of the client, shown instead of its host name.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the network the fixed IP belongs to.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("fixed_ip")),
+				},
+			},
+			"note": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the client belongs to. Setting this overrides the default site set in " +
+					"the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user group, i.e. bandwidth profile, applied to the client. " +
+					"Defaults to the default user group of the site.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (m *ClientResourceModel) toUnifiUser(user *unifi.User) {
+	// Empty strings are sent rather than leaving the fields out, so removing a value from the config clears it.
+	user.LocalDNSRecord = utils.StringPtr(m.LocalDNSRecord.ValueString())
+	user.LocalDNSRecordEnabled = !m.LocalDNSRecord.IsNull()
+	user.Name = utils.StringPtr(m.Name.ValueString())
+	user.NetworkID = m.NetworkID.ValueString()
+	user.Note = utils.StringPtr(m.Note.ValueString())
+	user.UseFixedIP = !m.FixedIP.IsNull()
+
+	user.FixedIP = nil
+	if !m.FixedIP.IsNull() {
+		user.FixedIP = m.FixedIP.ValueStringPointer()
+	}
+
+	user.UserGroupID = ""
+	if !m.UserGroupID.IsUnknown() {
+		user.UserGroupID = m.UserGroupID.ValueString()
+	}
+}
+
+func newClientResourceModel(user *unifi.User, site string, model ClientResourceModel) ClientResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(user.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(user.SiteID)
+
+	// Configurable Values
+	model.Blocked = types.BoolValue(user.Blocked != nil && *user.Blocked)
+	model.MAC = customtype.NewMacPointerValue(user.MAC)
+	model.Name = utils.EmptyStringNull(user.Name)
+	model.Note = utils.EmptyStringNull(user.Note)
+	model.UserGroupID = types.StringValue(user.UserGroupID)
+
+	// Only known to Terraform, so it needs setting when importing.
+	if model.ForgetOnDestroy.IsNull() {
+		model.ForgetOnDestroy = types.BoolValue(false)
+	}
+
+	model.FixedIP = iptypes.NewIPv4AddressNull()
+	model.NetworkID = types.StringNull()
+	if user.UseFixedIP {
+		model.FixedIP = iptypes.NewIPv4AddressPointerValue(user.FixedIP)
+		model.NetworkID = utils.EmptyStringNull(&user.NetworkID)
+	}
+
+	model.LocalDNSRecord = types.StringNull()
+	if user.LocalDNSRecordEnabled {
+		model.LocalDNSRecord = utils.EmptyStringNull(user.LocalDNSRecord)
+	}
+
+	return model
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccClientResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClientConfig("00:11:22:33:44:55", `fixed_ip = "192.168.1.50"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccClientConfig("00:11:22:33:44:55", `local_dns_record = "printer.lan"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccClientConfig("00-11-22-33-44-55", ""),
+				ExpectError: regexp.MustCompile(`Invalid Mac String Value`),
+			},
+		},
+	})
+}
+
+func TestAccClientResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccClientConfig("00:11:22:33:44:55", `forget_on_destroy = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_client.test", "mac", "00:11:22:33:44:55"),
+					resource.TestCheckResourceAttr("unifi_client.test", "name", "Test Client"),
+					resource.TestCheckResourceAttr("unifi_client.test", "blocked", "false"),
+					resource.TestCheckResourceAttrSet("unifi_client.test", "user_group_id"),
+					resource.TestCheckNoResourceAttr("unifi_client.test", "fixed_ip"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "unifi_client.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"forget_on_destroy"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccClientConfig("00:11:22:33:44:55", `
+  forget_on_destroy = true
+  note              = "Managed by Terraform"
+  blocked           = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_client.test", "note", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("unifi_client.test", "blocked", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClientConfig(mac, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_client" "test" {
+  mac  = %q
+  name = "Test Client"
+  %s
+}
+`, mac, settings)
+}
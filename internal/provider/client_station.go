@@ -0,0 +1,41 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// station is a client that is currently connected to the site. Unlike unifi.User this includes the details of how the
+// client is connected.
+type station struct {
+	ID        *string `json:"_id,omitempty"`
+	APMAC     *string `json:"ap_mac,omitempty"`
+	ESSID     *string `json:"essid,omitempty"`
+	Hostname  *string `json:"hostname,omitempty"`
+	IP        *string `json:"ip,omitempty"`
+	IsWired   *bool   `json:"is_wired,omitempty"`
+	LastSeen  *int64  `json:"last_seen,omitempty"`
+	MAC       *string `json:"mac,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	NetworkID *string `json:"network_id,omitempty"`
+	SwMAC     *string `json:"sw_mac,omitempty"`
+	SwPort    *int64  `json:"sw_port,omitempty"`
+	Uptime    *int64  `json:"uptime,omitempty"`
+}
+
+func (c *unifiClient) listStation(ctx context.Context, site string) ([]station, error) {
+	var respBody struct {
+		Meta clientMeta `json:"meta"`
+		Data []station  `json:"data"`
+	}
+
+	err := c.do(ctx, "GET", fmt.Sprintf("s/%s/stat/sta", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody.Data, nil
+}
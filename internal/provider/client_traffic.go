@@ -0,0 +1,261 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"reflect"
+	"strings"
+)
+
+// Traffic rules and routes are only available through the v2 API, which the SDK doesn't support. The API replaces the
+// whole object on update, so the fields that aren't modelled here are kept from the controller's copy and sent back.
+
+const (
+	trafficTargetDeviceTypeAllClients = "ALL_CLIENTS"
+	trafficTargetDeviceTypeClient     = "CLIENT"
+	trafficTargetDeviceTypeNetwork    = "NETWORK"
+)
+
+// trafficRule blocks, allows or rate limits traffic matching its target.
+type trafficRule struct {
+	ID             *string               `json:"_id,omitempty"`
+	Action         string                `json:"action"`
+	AppCategoryIDs []int64               `json:"app_category_ids"`
+	AppIDs         []int64               `json:"app_ids"`
+	BandwidthLimit trafficBandwidthLimit `json:"bandwidth_limit"`
+	Description    string                `json:"description"`
+	Domains        []trafficDomain       `json:"domains"`
+	Enabled        bool                  `json:"enabled"`
+	IPAddresses    []trafficIPAddress    `json:"ip_addresses"`
+	IPRanges       []json.RawMessage     `json:"ip_ranges"`
+	MatchingTarget string                `json:"matching_target"`
+	NetworkIDs     []string              `json:"network_ids"`
+	Regions        []string              `json:"regions"`
+	Schedule       trafficSchedule       `json:"schedule"`
+	TargetDevices  []trafficTargetDevice `json:"target_devices"`
+
+	// raw holds the rule as returned by the controller, including the fields not modelled above.
+	raw map[string]json.RawMessage
+}
+
+func (r *trafficRule) UnmarshalJSON(data []byte) error {
+	type rule trafficRule
+	if err := json.Unmarshal(data, (*rule)(r)); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &r.raw)
+}
+
+func (r trafficRule) MarshalJSON() ([]byte, error) {
+	type rule trafficRule
+	return marshalTrafficJSON(rule(r), r.raw)
+}
+
+// trafficRoute routes traffic matching its target through a WAN or VPN client network.
+type trafficRoute struct {
+	ID                *string               `json:"_id,omitempty"`
+	Description       string                `json:"description"`
+	Domains           []trafficDomain       `json:"domains"`
+	Enabled           bool                  `json:"enabled"`
+	IPAddresses       []trafficIPAddress    `json:"ip_addresses"`
+	IPRanges          []json.RawMessage     `json:"ip_ranges"`
+	KillSwitchEnabled bool                  `json:"kill_switch_enabled"`
+	MatchingTarget    string                `json:"matching_target"`
+	NetworkID         string                `json:"network_id"`
+	NextHop           string                `json:"next_hop"`
+	Regions           []string              `json:"regions"`
+	TargetDevices     []trafficTargetDevice `json:"target_devices"`
+
+	// raw holds the route as returned by the controller, including the fields not modelled above.
+	raw map[string]json.RawMessage
+}
+
+func (r *trafficRoute) UnmarshalJSON(data []byte) error {
+	type route trafficRoute
+	if err := json.Unmarshal(data, (*route)(r)); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &r.raw)
+}
+
+func (r trafficRoute) MarshalJSON() ([]byte, error) {
+	type route trafficRoute
+	return marshalTrafficJSON(route(r), r.raw)
+}
+
+// marshalTrafficJSON marshals v over the top of raw, so fields of raw that v doesn't model are sent back unchanged.
+// Modelled fields left out by omitempty are removed from raw rather than keeping their old value.
+func marshalTrafficJSON(v interface{}, raw map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(raw) == 0 {
+		return data, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]json.RawMessage, len(raw))
+	for key, value := range raw {
+		merged[key] = value
+	}
+
+	t := reflect.TypeOf(v)
+	for i := 0; i < t.NumField(); i++ {
+		key, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if key != "" && key != "-" {
+			delete(merged, key)
+		}
+	}
+
+	for key, value := range fields {
+		merged[key] = value
+	}
+
+	return json.Marshal(merged)
+}
+
+type trafficBandwidthLimit struct {
+	DownloadLimitKbps int64 `json:"download_limit_kbps"`
+	Enabled           bool  `json:"enabled"`
+	UploadLimitKbps   int64 `json:"upload_limit_kbps"`
+}
+
+type trafficDomain struct {
+	Domain     string            `json:"domain"`
+	PortRanges []json.RawMessage `json:"port_ranges"`
+	Ports      []int64           `json:"ports"`
+}
+
+type trafficIPAddress struct {
+	IPOrSubnet string            `json:"ip_or_subnet"`
+	IPVersion  string            `json:"ip_version"`
+	PortRanges []json.RawMessage `json:"port_ranges"`
+	Ports      []int64           `json:"ports"`
+}
+
+type trafficSchedule struct {
+	Mode           string   `json:"mode"`
+	RepeatOnDays   []string `json:"repeat_on_days"`
+	TimeAllDay     bool     `json:"time_all_day"`
+	TimeRangeEnd   string   `json:"time_range_end,omitempty"`
+	TimeRangeStart string   `json:"time_range_start,omitempty"`
+}
+
+type trafficTargetDevice struct {
+	ClientMAC string `json:"client_mac,omitempty"`
+	NetworkID string `json:"network_id,omitempty"`
+	Type      string `json:"type"`
+}
+
+func (c *unifiClient) listTrafficRule(ctx context.Context, site string) ([]trafficRule, error) {
+	var respBody []trafficRule
+
+	err := c.do(ctx, "GET", fmt.Sprintf("v2/site/%s/trafficrules", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+func (c *unifiClient) getTrafficRule(ctx context.Context, site, id string) (*trafficRule, error) {
+	rules, err := c.listTrafficRule(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.ID != nil && *rule.ID == id {
+			return &rule, nil
+		}
+	}
+
+	return nil, &unifi.NotFoundError{}
+}
+
+func (c *unifiClient) createTrafficRule(ctx context.Context, site string, rule *trafficRule) (*trafficRule, error) {
+	var respBody trafficRule
+
+	err := c.do(ctx, "POST", fmt.Sprintf("v2/site/%s/trafficrules", site), rule, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) updateTrafficRule(ctx context.Context, site string, rule *trafficRule) (*trafficRule, error) {
+	var respBody trafficRule
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("v2/site/%s/trafficrules/%s", site, *rule.ID), rule, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) deleteTrafficRule(ctx context.Context, site, id string) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("v2/site/%s/trafficrules/%s", site, id), nil, nil)
+}
+
+func (c *unifiClient) listTrafficRoute(ctx context.Context, site string) ([]trafficRoute, error) {
+	var respBody []trafficRoute
+
+	err := c.do(ctx, "GET", fmt.Sprintf("v2/site/%s/trafficroutes", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+func (c *unifiClient) getTrafficRoute(ctx context.Context, site, id string) (*trafficRoute, error) {
+	routes, err := c.listTrafficRoute(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, route := range routes {
+		if route.ID != nil && *route.ID == id {
+			return &route, nil
+		}
+	}
+
+	return nil, &unifi.NotFoundError{}
+}
+
+func (c *unifiClient) createTrafficRoute(ctx context.Context, site string, route *trafficRoute) (*trafficRoute, error) {
+	var respBody trafficRoute
+
+	err := c.do(ctx, "POST", fmt.Sprintf("v2/site/%s/trafficroutes", site), route, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) updateTrafficRoute(ctx context.Context, site string, route *trafficRoute) (*trafficRoute, error) {
+	var respBody trafficRoute
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("v2/site/%s/trafficroutes/%s", site, *route.ID), route, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respBody, nil
+}
+
+func (c *unifiClient) deleteTrafficRoute(ctx context.Context, site, id string) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("v2/site/%s/trafficroutes/%s", site, id), nil, nil)
+}
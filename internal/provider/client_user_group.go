@@ -0,0 +1,69 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+)
+
+// getDefaultUserGroupID returns the ID of the user group the controller creates for every site.
+func (c *unifiClient) getDefaultUserGroupID(ctx context.Context, site string) (string, error) {
+	groups, err := c.ListUserGroup(ctx, site)
+	if err != nil {
+		return "", err
+	}
+
+	for _, group := range groups {
+		if group.NoDelete != nil && *group.NoDelete && group.ID != nil {
+			return *group.ID, nil
+		}
+	}
+
+	for _, group := range groups {
+		if group.Name != nil && *group.Name == "Default" && group.ID != nil {
+			return *group.ID, nil
+		}
+	}
+
+	return "", &unifi.NotFoundError{}
+}
+
+// userGroupReferences returns a description of each client and WLAN that uses the user group.
+func (c *unifiClient) userGroupReferences(ctx context.Context, site, id string) ([]string, error) {
+	var references []string
+
+	users, err := c.ListUser(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if user.UserGroupID != id {
+			continue
+		}
+
+		name := utils.StringValue(user.Name)
+		if name == "" {
+			name = utils.StringValue(user.Hostname)
+		}
+
+		references = append(references, fmt.Sprintf("client %s (%s)", name, utils.StringValue(user.MAC)))
+	}
+
+	wlans, err := c.ListWLAN(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wlan := range wlans {
+		if wlan.UserGroupID == id {
+			references = append(references, fmt.Sprintf("WLAN %s (%s)", utils.StringValue(wlan.Name), utils.StringValue(wlan.ID)))
+		}
+	}
+
+	return references, nil
+}
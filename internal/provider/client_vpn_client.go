@@ -0,0 +1,29 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// vpnConnection is the state of a VPN tunnel of the gateway. Connections are only available through the v2 API, which
+// the SDK doesn't support.
+type vpnConnection struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+func (c *unifiClient) listVPNConnection(ctx context.Context, site string) ([]vpnConnection, error) {
+	var respBody struct {
+		Connections []vpnConnection `json:"connections"`
+	}
+
+	err := c.do(ctx, "GET", fmt.Sprintf("v2/site/%s/vpn/connections", site), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody.Connections, nil
+}
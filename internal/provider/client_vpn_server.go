@@ -0,0 +1,61 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+// The peers of a WireGuard VPN server are only available through the v2 API, which the SDK doesn't support.
+
+// wireguardPeer is a client allowed to connect to a WireGuard VPN server.
+type wireguardPeer struct {
+	ID           *string `json:"_id,omitempty"`
+	InterfaceIP  string  `json:"interface_ip"`
+	Name         string  `json:"name"`
+	NetworkID    string  `json:"network_id"`
+	PresharedKey string  `json:"preshared_key"`
+	PublicKey    string  `json:"public_key"`
+}
+
+func (c *unifiClient) listWireGuardPeer(ctx context.Context, site, networkID string) ([]wireguardPeer, error) {
+	var respBody []wireguardPeer
+
+	err := c.do(ctx, "GET", fmt.Sprintf("v2/site/%s/wireguard/%s/users", site, networkID), nil, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+// createWireGuardPeers creates peers. The controller only supports changing peers in batches.
+func (c *unifiClient) createWireGuardPeers(ctx context.Context, site, networkID string, peers []wireguardPeer) ([]wireguardPeer, error) {
+	var respBody []wireguardPeer
+
+	err := c.do(ctx, "POST", fmt.Sprintf("v2/site/%s/wireguard/%s/users/batch", site, networkID), peers, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+// updateWireGuardPeers updates peers. The controller only supports changing peers in batches.
+func (c *unifiClient) updateWireGuardPeers(ctx context.Context, site, networkID string, peers []wireguardPeer) ([]wireguardPeer, error) {
+	var respBody []wireguardPeer
+
+	err := c.do(ctx, "PUT", fmt.Sprintf("v2/site/%s/wireguard/%s/users/batch", site, networkID), peers, &respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
+
+// deleteWireGuardPeers deletes peers. The controller only supports changing peers in batches.
+func (c *unifiClient) deleteWireGuardPeers(ctx context.Context, site, networkID string, ids []string) error {
+	return c.do(ctx, "POST", fmt.Sprintf("v2/site/%s/wireguard/%s/users/batch_delete", site, networkID), ids, nil)
+}
@@ -0,0 +1,309 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customtype"
+	"strings"
+	"time"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClientsDataSource{}
+
+func NewClientsDataSource() datasource.DataSource {
+	return &ClientsDataSource{}
+}
+
+// ClientsDataSource defines the data source implementation.
+type ClientsDataSource struct {
+	client *unifiClient
+}
+
+// ClientsDataSourceModel describes the data source data model.
+type ClientsDataSourceModel struct {
+	DeviceMac      customtype.Mac `tfsdk:"device_mac"`
+	IncludeOffline types.Bool     `tfsdk:"include_offline"`
+	NetworkID      types.String   `tfsdk:"network_id"`
+	Online         types.Bool     `tfsdk:"online"`
+	Site           types.String   `tfsdk:"site"`
+
+	// Read Only
+	Clients []ClientDataSourceModel `tfsdk:"clients"`
+}
+
+type ClientDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Alias      types.String `tfsdk:"alias"`
+	APMac      types.String `tfsdk:"ap_mac"`
+	Hostname   types.String `tfsdk:"hostname"`
+	IP         types.String `tfsdk:"ip"`
+	LastSeen   types.String `tfsdk:"last_seen"`
+	Mac        types.String `tfsdk:"mac"`
+	NetworkID  types.String `tfsdk:"network_id"`
+	Online     types.Bool   `tfsdk:"online"`
+	SSID       types.String `tfsdk:"ssid"`
+	SwitchMac  types.String `tfsdk:"switch_mac"`
+	SwitchPort types.Int64  `tfsdk:"switch_port"`
+	Uptime     types.Int64  `tfsdk:"uptime"`
+	Wired      types.Bool   `tfsdk:"wired"`
+}
+
+func (d *ClientsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clients"
+}
+
+func (d *ClientsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the clients connected to, or known by, a Unifi site",
+
+		Attributes: map[string]schema.Attribute{
+			"device_mac": schema.StringAttribute{
+				MarkdownDescription: "Only return clients connected to the switch or access point with this MAC address.",
+				Optional:            true,
+				CustomType:          customtype.MacType{},
+			},
+			"include_offline": schema.BoolAttribute{
+				MarkdownDescription: "When true, clients the controller has seen before but that are not currently " +
+					"connected are also returned. Default: `false`",
+				Optional: true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Only return clients on the network with this ID.",
+				Optional:            true,
+			},
+			"online": schema.BoolAttribute{
+				MarkdownDescription: "Only return clients with a matching online status. Use with `include_offline` " +
+					"to return only clients that are not connected.",
+				Optional: true,
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site to list clients for. When set this overrides the default provider site",
+				Computed:            true,
+				Optional:            true,
+			},
+
+			// Read only
+			"clients": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Client identifier",
+							Computed:            true,
+						},
+						"alias": schema.StringAttribute{
+							MarkdownDescription: "The name given to the client in the controller.",
+							Computed:            true,
+						},
+						"ap_mac": schema.StringAttribute{
+							MarkdownDescription: "The MAC address of the access point a wireless client is connected to.",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							Computed: true,
+						},
+						"ip": schema.StringAttribute{
+							Computed: true,
+						},
+						"last_seen": schema.StringAttribute{
+							MarkdownDescription: "The time the client was last seen, in RFC3339 format.",
+							Computed:            true,
+						},
+						"mac": schema.StringAttribute{
+							Computed: true,
+						},
+						"network_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"online": schema.BoolAttribute{
+							MarkdownDescription: "Whether the client is currently connected.",
+							Computed:            true,
+						},
+						"ssid": schema.StringAttribute{
+							MarkdownDescription: "The SSID a wireless client is connected to.",
+							Computed:            true,
+						},
+						"switch_mac": schema.StringAttribute{
+							MarkdownDescription: "The MAC address of the switch a wired client is connected to.",
+							Computed:            true,
+						},
+						"switch_port": schema.Int64Attribute{
+							MarkdownDescription: "The port on the switch a wired client is connected to.",
+							Computed:            true,
+						},
+						"uptime": schema.Int64Attribute{
+							MarkdownDescription: "The number of seconds the client has been connected.",
+							Computed:            true,
+						},
+						"wired": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClientsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClientsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := data.Site.ValueString()
+	if site == "" {
+		site = d.client.site
+	}
+
+	data.Site = types.StringValue(site)
+
+	stations, err := d.client.listStation(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list active clients, got error: %s", err))
+		return
+	}
+
+	clients := make([]ClientDataSourceModel, 0, len(stations))
+	online := make(map[string]bool, len(stations))
+	for _, sta := range stations {
+		if sta.MAC != nil {
+			online[strings.ToLower(*sta.MAC)] = true
+		}
+
+		clients = append(clients, newClientDataSourceModel(sta))
+	}
+
+	if data.IncludeOffline.ValueBool() {
+		users, err := d.client.ListUser(ctx, site)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list known clients, got error: %s", err))
+			return
+		}
+
+		for _, user := range users {
+			if user.MAC == nil || online[strings.ToLower(*user.MAC)] {
+				continue
+			}
+
+			clients = append(clients, newOfflineClientDataSourceModel(user))
+		}
+	}
+
+	data.Clients = make([]ClientDataSourceModel, 0, len(clients))
+	for _, client := range clients {
+		if !data.matches(client) {
+			continue
+		}
+
+		data.Clients = append(data.Clients, client)
+	}
+
+	tflog.Trace(ctx, "clients read", map[string]interface{}{"site": site, "count": len(data.Clients)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matches returns true when the client satisfies all the filters set on the data source.
+func (m *ClientsDataSourceModel) matches(client ClientDataSourceModel) bool {
+	if !m.Online.IsNull() && m.Online.ValueBool() != client.Online.ValueBool() {
+		return false
+	}
+
+	if !m.NetworkID.IsNull() && m.NetworkID.ValueString() != client.NetworkID.ValueString() {
+		return false
+	}
+
+	if !m.DeviceMac.IsNull() {
+		mac := m.DeviceMac.ValueString()
+		if !strings.EqualFold(mac, client.SwitchMac.ValueString()) && !strings.EqualFold(mac, client.APMac.ValueString()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newClientDataSourceModel(sta station) ClientDataSourceModel {
+	model := ClientDataSourceModel{
+		ID:         types.StringPointerValue(sta.ID),
+		Alias:      types.StringPointerValue(sta.Name),
+		APMac:      types.StringPointerValue(sta.APMAC),
+		Hostname:   types.StringPointerValue(sta.Hostname),
+		IP:         types.StringPointerValue(sta.IP),
+		LastSeen:   types.StringNull(),
+		Mac:        types.StringPointerValue(sta.MAC),
+		NetworkID:  types.StringPointerValue(sta.NetworkID),
+		Online:     types.BoolValue(true),
+		SSID:       types.StringPointerValue(sta.ESSID),
+		SwitchMac:  types.StringPointerValue(sta.SwMAC),
+		SwitchPort: types.Int64PointerValue(sta.SwPort),
+		Uptime:     types.Int64PointerValue(sta.Uptime),
+		Wired:      types.BoolPointerValue(sta.IsWired),
+	}
+
+	if sta.LastSeen != nil {
+		model.LastSeen = types.StringValue(time.Unix(*sta.LastSeen, 0).UTC().Format(time.RFC3339))
+	}
+
+	return model
+}
+
+func newOfflineClientDataSourceModel(user unifi.User) ClientDataSourceModel {
+	model := ClientDataSourceModel{
+		ID:         types.StringPointerValue(user.ID),
+		Alias:      types.StringPointerValue(user.Name),
+		APMac:      types.StringNull(),
+		Hostname:   types.StringPointerValue(user.Hostname),
+		IP:         types.StringPointerValue(user.IP),
+		LastSeen:   types.StringNull(),
+		Mac:        types.StringPointerValue(user.MAC),
+		NetworkID:  types.StringValue(user.NetworkID),
+		Online:     types.BoolValue(false),
+		SSID:       types.StringNull(),
+		SwitchMac:  types.StringNull(),
+		SwitchPort: types.Int64Null(),
+		Uptime:     types.Int64Null(),
+		Wired:      types.BoolNull(),
+	}
+
+	if user.LastSeen != nil {
+		model.LastSeen = types.StringValue(time.Unix(int64(*user.LastSeen), 0).UTC().Format(time.RFC3339))
+	}
+
+	return model
+}
@@ -0,0 +1,34 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccClientsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unifi_clients.test", "site", "default"),
+					resource.TestCheckResourceAttrSet("data.unifi_clients.test", "clients.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccClientsDataSourceConfig = `
+provider "unifi" {}
+data "unifi_clients" "test" {
+  include_offline = true
+}
+`
@@ -0,0 +1,56 @@
+package customplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringDefaultToPath is a plan modifier that sets an unconfigured value to the value of another attribute, so the
+// value is known when planning instead of after apply.
+type stringDefaultToPath struct {
+	expression path.Expression
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m stringDefaultToPath) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m stringDefaultToPath) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("When not configured the value defaults to the value of %s.", m.expression)
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m stringDefaultToPath) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing when there is a configured value or nothing to plan.
+	if !req.ConfigValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	matchedPaths, diags := req.Config.PathMatches(ctx, req.PathExpression.Merge(m.expression))
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || len(matchedPaths) != 1 {
+		return
+	}
+
+	var value types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, matchedPaths[0], &value)...)
+
+	// Leave the value to be known after apply when the other value isn't known yet.
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = value
+}
+
+// StringDefaultToPath returns a string plan modifier that sets the value to the value at expression when it isn't
+// configured.
+func StringDefaultToPath(expression path.Expression) planmodifier.String {
+	return stringDefaultToPath{expression: expression}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
 	"strconv"
+	"time"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -34,14 +35,25 @@ type DeviceDataSourceModel struct {
 	Site types.String `tfsdk:"site"`
 
 	// Read Only
-	ID            types.String                                 `tfsdk:"id"`
-	Adopted       types.Bool                                   `tfsdk:"adopted"`
-	Disabled      types.Bool                                   `tfsdk:"disabled"`
-	Model         types.String                                 `tfsdk:"model"`
-	Name          types.String                                 `tfsdk:"name"`
-	PortOverrides map[string]DevicePortOverrideDataSourceModel `tfsdk:"port_overrides"`
-	State         types.String                                 `tfsdk:"state"`
-	Type          types.String                                 `tfsdk:"type"`
+	ID                       types.String                                 `tfsdk:"id"`
+	Adopted                  types.Bool                                   `tfsdk:"adopted"`
+	CPUUtilization           types.Float64                                `tfsdk:"cpu_utilization"`
+	Disabled                 types.Bool                                   `tfsdk:"disabled"`
+	FirmwareVersion          types.String                                 `tfsdk:"firmware_version"`
+	IP                       types.String                                 `tfsdk:"ip"`
+	LastSeen                 types.String                                 `tfsdk:"last_seen"`
+	MemoryUtilization        types.Float64                                `tfsdk:"memory_utilization"`
+	Model                    types.String                                 `tfsdk:"model"`
+	Name                     types.String                                 `tfsdk:"name"`
+	PortOverrides            map[string]DevicePortOverrideDataSourceModel `tfsdk:"port_overrides"`
+	Serial                   types.String                                 `tfsdk:"serial"`
+	State                    types.String                                 `tfsdk:"state"`
+	Temperatures             []DeviceTemperatureDataSourceModel           `tfsdk:"temperatures"`
+	Type                     types.String                                 `tfsdk:"type"`
+	Upgradable               types.Bool                                   `tfsdk:"upgradable"`
+	UpgradeToFirmwareVersion types.String                                 `tfsdk:"upgrade_to_firmware_version"`
+	Uplink                   *DeviceUplinkDataSourceModel                 `tfsdk:"uplink"`
+	Uptime                   types.Int64                                  `tfsdk:"uptime"`
 }
 
 type DevicePortOverrideDataSourceModel struct {
@@ -56,6 +68,17 @@ type DevicePortOverrideDataSourceModel struct {
 	PortSecurityMACAddresses types.List   `tfsdk:"port_security_mac_addresses"`
 }
 
+type DeviceTemperatureDataSourceModel struct {
+	Name  types.String  `tfsdk:"name"`
+	Type  types.String  `tfsdk:"type"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+type DeviceUplinkDataSourceModel struct {
+	Mac  types.String `tfsdk:"mac"`
+	Port types.Int64  `tfsdk:"port"`
+}
+
 func (d *DeviceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_device"
 }
@@ -86,9 +109,29 @@ func (d *DeviceDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			"adopted": schema.BoolAttribute{
 				Computed: true,
 			},
+			"cpu_utilization": schema.Float64Attribute{
+				MarkdownDescription: "The CPU utilisation of the device as a percentage.",
+				Computed:            true,
+			},
 			"disabled": schema.BoolAttribute{
 				Computed: true,
 			},
+			"firmware_version": schema.StringAttribute{
+				MarkdownDescription: "The firmware version currently running on the device.",
+				Computed:            true,
+			},
+			"ip": schema.StringAttribute{
+				MarkdownDescription: "The IP address of the device.",
+				Computed:            true,
+			},
+			"last_seen": schema.StringAttribute{
+				MarkdownDescription: "The time the controller last heard from the device, in RFC3339 format.",
+				Computed:            true,
+			},
+			"memory_utilization": schema.Float64Attribute{
+				MarkdownDescription: "The memory utilisation of the device as a percentage.",
+				Computed:            true,
+			},
 			"model": schema.StringAttribute{
 				Computed: true,
 			},
@@ -134,12 +177,60 @@ func (d *DeviceDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 					},
 				},
 			},
+			"serial": schema.StringAttribute{
+				MarkdownDescription: "The serial number of the device.",
+				Computed:            true,
+			},
 			"state": schema.StringAttribute{
 				Computed: true,
 			},
+			"temperatures": schema.ListNestedAttribute{
+				MarkdownDescription: "The temperature sensors reported by the device.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"value": schema.Float64Attribute{
+							MarkdownDescription: "The temperature in degrees Celsius.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"type": schema.StringAttribute{
 				Computed: true,
 			},
+			"upgradable": schema.BoolAttribute{
+				MarkdownDescription: "Whether a firmware upgrade is available for the device.",
+				Computed:            true,
+			},
+			"upgrade_to_firmware_version": schema.StringAttribute{
+				MarkdownDescription: "The firmware version the device can be upgraded to.",
+				Computed:            true,
+			},
+			"uplink": schema.SingleNestedAttribute{
+				MarkdownDescription: "The upstream device the device is connected to.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"mac": schema.StringAttribute{
+						MarkdownDescription: "The MAC address of the uplink device.",
+						Computed:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The port on the uplink device the device is connected to.",
+						Computed:            true,
+					},
+				},
+			},
+			"uptime": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds the device has been running.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -195,6 +286,48 @@ func (d *DeviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.State = types.StringValue(device.State.String())
 	data.Type = types.StringPointerValue(device.Type)
 
+	stats, err := d.client.getDeviceStats(ctx, site, data.Mac.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read device statistics, got error: %s", err))
+		return
+	}
+
+	data.FirmwareVersion = types.StringPointerValue(stats.Version)
+	data.IP = types.StringPointerValue(stats.IP)
+	data.Serial = types.StringPointerValue(stats.Serial)
+	data.Upgradable = types.BoolPointerValue(stats.Upgradable)
+	data.UpgradeToFirmwareVersion = types.StringPointerValue(stats.UpgradeToFirmware)
+	data.Uptime = types.Int64PointerValue(stats.Uptime)
+
+	data.LastSeen = types.StringNull()
+	if stats.LastSeen != nil {
+		data.LastSeen = types.StringValue(time.Unix(*stats.LastSeen, 0).UTC().Format(time.RFC3339))
+	}
+
+	data.CPUUtilization = types.Float64Null()
+	data.MemoryUtilization = types.Float64Null()
+	if stats.SystemStats != nil {
+		data.CPUUtilization = types.Float64PointerValue(stats.SystemStats.CPU.Float64Pointer())
+		data.MemoryUtilization = types.Float64PointerValue(stats.SystemStats.Mem.Float64Pointer())
+	}
+
+	data.Temperatures = make([]DeviceTemperatureDataSourceModel, 0, len(stats.Temperatures))
+	for _, temperature := range stats.Temperatures {
+		data.Temperatures = append(data.Temperatures, DeviceTemperatureDataSourceModel{
+			Name:  types.StringPointerValue(temperature.Name),
+			Type:  types.StringPointerValue(temperature.Type),
+			Value: types.Float64PointerValue(temperature.Value),
+		})
+	}
+
+	data.Uplink = nil
+	if stats.Uplink != nil && stats.Uplink.UplinkMAC != nil {
+		data.Uplink = &DeviceUplinkDataSourceModel{
+			Mac:  types.StringPointerValue(stats.Uplink.UplinkMAC),
+			Port: types.Int64PointerValue(stats.Uplink.UplinkRemotePort),
+		}
+	}
+
 	data.PortOverrides = make(map[string]DevicePortOverrideDataSourceModel, len(device.PortOverrides))
 	for _, override := range device.PortOverrides {
 		excludedNetworkIDs := types.ListNull(types.StringType)
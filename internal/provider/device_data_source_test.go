@@ -43,6 +43,8 @@ func TestAccDeviceDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.unifi_device.test", "mac", "dc:9f:db:00:00:01"),
 					resource.TestCheckResourceAttr("data.unifi_device.test", "type", "ugw"),
 					resource.TestCheckResourceAttr("data.unifi_device.test", "type", "ugw"),
+					resource.TestCheckResourceAttrSet("data.unifi_device.test", "firmware_version"),
+					resource.TestCheckResourceAttrSet("data.unifi_device.test", "serial"),
 				),
 			},
 		},
@@ -5,9 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
-	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -58,6 +56,8 @@ var (
 	defaultDeviceSwitchPortOverrideModel             = DeviceSwitchPortOverrideResourceModel{}
 	defaultDeviceSwitchResourceModel                 = DeviceSwitchResourceModel{}
 	defaultDeviceSwitchStaticIPSettingsResourceModel = DeviceSwitchStaticIPSettingResourceModel{}
+
+	netmaskRegexp = regexp.MustCompile(`^((128|192|224|240|248|252|254)\.0\.0\.0)|(255\.(((0|128|192|224|240|248|252|254)\.0\.0)|(255\.(((0|128|192|224|240|248|252|254)\.0)|255\.(0|128|192|224|240|248|252|254)))))$`)
 )
 
 func NewDeviceSwitchResource() resource.Resource {
@@ -608,7 +608,7 @@ func (m *DeviceSwitchStaticIPSettingResourceModel) schema() schema.Attribute {
 				Required:   true,
 				CustomType: iptypes.IPv4AddressType{},
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(regexp.MustCompile(`^((128|192|224|240|248|252|254)\.0\.0\.0)|(255\.(((0|128|192|224|240|248|252|254)\.0\.0)|(255\.(((0|128|192|224|240|248|252|254)\.0)|255\.(0|128|192|224|240|248|252|254)))))$`), "invalid net mask"),
+					stringvalidator.RegexMatches(netmaskRegexp, "invalid net mask"),
 				},
 			},
 			"preferred_dns": schema.StringAttribute{
@@ -781,9 +781,7 @@ func (m *DeviceSwitchPortOverrideResourceModel) schema() schema.NestedAttributeO
 				MarkdownDescription: "One or more VLANs that are tagged on this port.",
 				ElementType:         types.StringType,
 				Optional:            true,
-				Validators: []validator.List{
-					listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("tagged_vlan_management")),
-				},
+				Validators:          portExcludedTaggedNetworkIDsValidators(),
 			},
 			// "fec_mode": schema.StringAttribute{
 			// 	Computed: true,
@@ -792,10 +790,8 @@ func (m *DeviceSwitchPortOverrideResourceModel) schema() schema.NestedAttributeO
 			// 	Computed: true,
 			// },
 			"full_duplex": schema.BoolAttribute{
-				Optional: true,
-				Validators: []validator.Bool{
-					boolvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("link_speed")),
-				},
+				Optional:   true,
+				Validators: portFullDuplexValidators(),
 			},
 			// "isolation": schema.BoolAttribute{
 			// 	MarkdownDescription: "Allows you to prohibit traffic between isolated ports. This only " +
@@ -805,10 +801,7 @@ func (m *DeviceSwitchPortOverrideResourceModel) schema() schema.NestedAttributeO
 			"link_speed": schema.Int32Attribute{
 				MarkdownDescription: "An override for the link speed of the port.",
 				Optional:            true,
-				Validators: []validator.Int32{
-					int32validator.OneOf(10, 100, 1000, 2500, 5000, 10000, 20000, 25000, 40000, 50000, 100000),
-					int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("full_duplex")),
-				},
+				Validators:          portLinkSpeedValidators(),
 			},
 			// "lldp_med_enabled": schema.BoolAttribute{
 			// 	MarkdownDescription: "Extension for LLPD user alongside the voice VLAN feature to " +
@@ -870,12 +863,10 @@ func (m *DeviceSwitchPortOverrideResourceModel) schema() schema.NestedAttributeO
 				},
 			},
 			"poe_mode": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
-				Default:  stringdefault.StaticString("auto"),
-				Validators: []validator.String{
-					stringvalidator.OneOf("auto", "pasv24", "passthrough", "off"),
-				},
+				Computed:   true,
+				Optional:   true,
+				Default:    stringdefault.StaticString("auto"),
+				Validators: portPOEModeValidators(),
 			},
 			// "port_keepalive_enabled": schema.BoolAttribute{
 			// 	Computed: true,
@@ -1001,14 +992,12 @@ func (m *DeviceSwitchPortOverrideResourceModel) schema() schema.NestedAttributeO
 					customplanmodifier.PortOverridePortProfileIDString(),
 					customplanmodifier.PortOverrideDisabledString("block_all"),
 				},
-				Validators: []validator.String{
-					stringvalidator.OneOf("auto", "block_all", "custom"),
+				Validators: append(portTaggedVLANManagementValidators(),
 					stringvalidator.ConflictsWith(
 						path.MatchRelative().AtParent().AtName("disabled"),
 						path.MatchRelative().AtParent().AtName("port_profile_id"),
 					),
-					customvalidator.StringValueWithPaths("custom", path.MatchRelative().AtParent().AtName("excluded_tagged_network_ids")),
-				},
+				),
 			},
 			// "voice_networkconf_id": schema.StringAttribute{
 			// 	MarkdownDescription: "Uses LLPD-MED to place a VoIP phone on the specified VLAN. Devices " +
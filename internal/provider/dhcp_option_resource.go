@@ -0,0 +1,554 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customtype"
+	"regexp"
+	"strconv"
+)
+
+const (
+	dhcpOptionTypeBoolean    = "boolean"
+	dhcpOptionTypeHexArray   = "hexarray"
+	dhcpOptionTypeInteger    = "integer"
+	dhcpOptionTypeIPAddress  = "ipaddress"
+	dhcpOptionTypeMACAddress = "macaddress"
+	dhcpOptionTypeText       = "text"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &DHCPOptionResource{}
+	_ resource.ResourceWithImportState    = &DHCPOptionResource{}
+	_ resource.ResourceWithValidateConfig = &DHCPOptionResource{}
+
+	defaultDHCPOptionResourceModel = DHCPOptionResourceModel{}
+
+	dhcpOptionHexArrayRegexp = regexp.MustCompile(`^[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2})*$`)
+
+	// dhcpOptionReservedCodes are the option codes the controller manages itself, mapped to where they are configured.
+	// Options 43, 66 and 67 are left out as devices other than UniFi ones often need vendor specific or free-form
+	// values for them.
+	dhcpOptionReservedCodes = map[int32]string{
+		15:  "the domain name of the network",
+		42:  "`dhcp_server.ntp_servers` on `unifi_network`",
+		44:  "`dhcp_server.wins_servers` on `unifi_network`",
+		51:  "`dhcp_server.lease_time` on `unifi_network`",
+		252: "`dhcp_server.wpad_url` on `unifi_network`",
+	}
+)
+
+func NewDHCPOptionResource() resource.Resource {
+	return &DHCPOptionResource{}
+}
+
+// DHCPOptionResource defines the resource implementation.
+type DHCPOptionResource struct {
+	client *unifiClient
+}
+
+func (r *DHCPOptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dhcp_option"
+}
+
+func (r *DHCPOptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultDHCPOptionResourceModel.schema()
+}
+
+func (r *DHCPOptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DHCPOptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DHCPOptionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate(ctx)...)
+}
+
+func (r *DHCPOptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DHCPOptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	option := &unifi.DHCPOption{}
+	data.toDHCPOption(option)
+
+	values, diags := data.networkValues(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	option, err := r.client.createDHCPOption(ctx, site, option)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DHCP option, got error: %s", err))
+		return
+	}
+
+	if err = r.client.setDHCPOptionValues(ctx, site, *option.ID, values); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set DHCP option values, got error: %s", err))
+		return
+	}
+
+	data, diags = newDHCPOptionResourceModel(ctx, option, values, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "DHCP option created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DHCPOptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DHCPOptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	option, err := r.client.getDHCPOption(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DHCP option, got error: %s", err))
+		return
+	}
+
+	values, err := r.client.getDHCPOptionValues(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DHCP option values, got error: %s", err))
+		return
+	}
+
+	data, diags := newDHCPOptionResourceModel(ctx, option, values, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DHCPOptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DHCPOptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	values, diags := data.networkValues(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Start from the current option so settings that aren't managed by the resource are left untouched.
+	option, err := r.client.getDHCPOption(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DHCP option, got error: %s", err))
+		return
+	}
+
+	data.toDHCPOption(option)
+
+	option, err = r.client.updateDHCPOption(ctx, site, option)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DHCP option, got error: %s", err))
+		return
+	}
+
+	if err = r.client.setDHCPOptionValues(ctx, site, *option.ID, values); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set DHCP option values, got error: %s", err))
+		return
+	}
+
+	data, diags = newDHCPOptionResourceModel(ctx, option, values, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DHCPOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DHCPOptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// The controller won't delete an option while networks still set it.
+	if err := r.client.setDHCPOptionValues(ctx, site, data.ID.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove DHCP option values, got error: %s", err))
+		return
+	}
+
+	err := r.client.deleteDHCPOption(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DHCP option, got error: %s", err))
+		return
+	}
+}
+
+func (r *DHCPOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type DHCPOptionResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	Code          types.Int32  `tfsdk:"code"`
+	Name          types.String `tfsdk:"name"`
+	NetworkValues types.Map    `tfsdk:"network_values"`
+	Signed        types.Bool   `tfsdk:"signed"`
+	Site          types.String `tfsdk:"site"`
+	Type          types.String `tfsdk:"type"`
+	Width         types.Int32  `tfsdk:"width"`
+}
+
+func (m *DHCPOptionResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A custom DHCP option, and the value networks hand out for it.\n\n" +
+			"Options the controller manages itself, such as 42, 44 and 51, can't be defined. They are configured " +
+			"with the `dhcp_server` attribute of `unifi_network` instead. Options 43, 66 and 67 can be defined for " +
+			"vendor specific or free-form values, but shouldn't be combined with the `unifi_controller`, " +
+			"`tftp_server` or `boot_filename` settings of a network that the option is handed out on.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi DHCP option identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"code": schema.Int32Attribute{
+				MarkdownDescription: "The code of the option.",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(7, 254),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the option.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9-_]{1,25}$`), "must be 1 to 25 letters, digits, hyphens or underscores"),
+				},
+			},
+			"network_values": schema.MapAttribute{
+				MarkdownDescription: "The value of the option handed out by each network, keyed by network ID. Values " +
+					"must match `type`: `true` or `false` for `boolean`, colon separated hex bytes such as `01:0a:ff` " +
+					"for `hexarray`, a whole number that fits in `width` for `integer`, an IPv4 address for " +
+					"`ipaddress`, a lower case MAC address for `macaddress` and any text for `text`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 255)),
+				},
+			},
+			"signed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the value of an `integer` option is signed. Default: `false`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the DHCP option belongs to. Setting this overrides the default site set " +
+					"in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the option's value. One of `boolean`, `hexarray`, `integer`, " +
+					"`ipaddress`, `macaddress` or `text`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						dhcpOptionTypeBoolean,
+						dhcpOptionTypeHexArray,
+						dhcpOptionTypeInteger,
+						dhcpOptionTypeIPAddress,
+						dhcpOptionTypeMACAddress,
+						dhcpOptionTypeText,
+					),
+				},
+			},
+			"width": schema.Int32Attribute{
+				MarkdownDescription: "The width in bits of the value of an `integer` option. One of `8`, `16` or `32`. " +
+					"Required for, and only valid with, `integer` options.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.OneOf(8, 16, 32),
+				},
+			},
+		},
+	}
+}
+
+// validate checks the code isn't reserved, the integer settings are only used by integer options and each network value
+// matches the type of the option.
+func (m *DHCPOptionResourceModel) validate(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.Code.IsUnknown() && !m.Code.IsNull() {
+		if setting, ok := dhcpOptionReservedCodes[m.Code.ValueInt32()]; ok {
+			diags.AddAttributeError(
+				path.Root("code"),
+				"Reserved DHCP Option Code",
+				fmt.Sprintf("Option %d is managed by the controller and is configured with %s.", m.Code.ValueInt32(), setting),
+			)
+		}
+	}
+
+	if m.Type.IsUnknown() || m.Type.IsNull() {
+		return diags
+	}
+
+	optionType := m.Type.ValueString()
+	if optionType == dhcpOptionTypeInteger {
+		if m.Width.IsNull() {
+			diags.AddAttributeError(
+				path.Root("width"),
+				"Invalid Attribute Combination",
+				"width must be set for integer options.",
+			)
+		}
+	} else {
+		if !m.Width.IsUnknown() && !m.Width.IsNull() {
+			diags.AddAttributeError(
+				path.Root("width"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("width can't be set for %s options.", optionType),
+			)
+		}
+
+		if !m.Signed.IsUnknown() && m.Signed.ValueBool() {
+			diags.AddAttributeError(
+				path.Root("signed"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("signed can't be enabled for %s options.", optionType),
+			)
+		}
+	}
+
+	if m.NetworkValues.IsUnknown() || m.NetworkValues.IsNull() {
+		return diags
+	}
+
+	for networkID, element := range m.NetworkValues.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() || value.IsNull() {
+			continue
+		}
+
+		diags.Append(m.validateValue(ctx, path.Root("network_values").AtMapKey(networkID), value.ValueString())...)
+	}
+
+	return diags
+}
+
+// validateValue checks a network value is in the format required by the type of the option.
+func (m *DHCPOptionResourceModel) validateValue(ctx context.Context, p path.Path, value string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	invalid := func(format string) {
+		diags.AddAttributeError(
+			p,
+			"Invalid DHCP Option Value",
+			fmt.Sprintf("The value of a %s option must be %s, got: %s.", m.Type.ValueString(), format, value),
+		)
+	}
+
+	switch m.Type.ValueString() {
+	case dhcpOptionTypeBoolean:
+		if value != "true" && value != "false" {
+			invalid("`true` or `false`")
+		}
+	case dhcpOptionTypeHexArray:
+		if !dhcpOptionHexArrayRegexp.MatchString(value) {
+			invalid("colon separated hex bytes")
+		}
+	case dhcpOptionTypeInteger:
+		if m.Width.IsUnknown() || m.Width.IsNull() || m.Signed.IsUnknown() {
+			return diags
+		}
+
+		width := int(m.Width.ValueInt32())
+		var err error
+		if m.Signed.ValueBool() {
+			_, err = strconv.ParseInt(value, 10, width)
+		} else {
+			_, err = strconv.ParseUint(value, 10, width)
+		}
+
+		if err != nil {
+			invalid(fmt.Sprintf("a whole number that fits in %d bits", width))
+		}
+	case dhcpOptionTypeIPAddress:
+		diags.Append(iptypes.IPv4AddressType{}.Validate(ctx, tftypes.NewValue(tftypes.String, value), p)...)
+	case dhcpOptionTypeMACAddress:
+		var resp xattr.ValidateAttributeResponse
+		customtype.NewMacValue(value).ValidateAttribute(ctx, xattr.ValidateAttributeRequest{Path: p}, &resp)
+		diags.Append(resp.Diagnostics...)
+	}
+
+	return diags
+}
+
+// networkValues returns the configured network values keyed by network ID.
+func (m *DHCPOptionResourceModel) networkValues(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	values := make(map[string]string)
+	if m.NetworkValues.IsNull() || m.NetworkValues.IsUnknown() {
+		return values, nil
+	}
+
+	diags := m.NetworkValues.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+func (m *DHCPOptionResourceModel) toDHCPOption(option *unifi.DHCPOption) {
+	code := strconv.Itoa(int(m.Code.ValueInt32()))
+	option.Code = &code
+	option.Name = m.Name.ValueStringPointer()
+	option.Signed = m.Signed.ValueBool()
+	option.Type = m.Type.ValueStringPointer()
+
+	option.Width = nil
+	if !m.Width.IsNull() {
+		width := int(m.Width.ValueInt32())
+		option.Width = &width
+	}
+}
+
+func newDHCPOptionResourceModel(ctx context.Context, option *unifi.DHCPOption, values map[string]string, site string, model DHCPOptionResourceModel) (DHCPOptionResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(option.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.Code = types.Int32Null()
+	if option.Code != nil {
+		code, err := strconv.Atoi(*option.Code)
+		if err != nil {
+			diags.AddError("Invalid DHCP Option", fmt.Sprintf("Unable to parse code %q, got error: %s", *option.Code, err))
+			return model, diags
+		}
+
+		model.Code = types.Int32Value(int32(code))
+	}
+
+	model.Name = types.StringPointerValue(option.Name)
+	model.Signed = types.BoolValue(option.Signed)
+	model.Type = types.StringPointerValue(option.Type)
+
+	model.Width = types.Int32Null()
+	if option.Width != nil && model.Type.ValueString() == dhcpOptionTypeInteger {
+		model.Width = types.Int32Value(int32(*option.Width))
+	}
+
+	model.NetworkValues = types.MapNull(types.StringType)
+	if len(values) > 0 {
+		var d diag.Diagnostics
+		model.NetworkValues, d = types.MapValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+	}
+
+	return model, diags
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDHCPOptionResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDHCPOptionConfig(252, "text", "", `"http://wpad.example.com/wpad.dat"`),
+				ExpectError: regexp.MustCompile(`Reserved DHCP Option Code`),
+			},
+			{
+				Config:      testAccDHCPOptionConfig(150, "text", `width = 8`, `"tftp.example.com"`),
+				ExpectError: regexp.MustCompile(`width can't be set for text options`),
+			},
+			{
+				Config:      testAccDHCPOptionConfig(150, "integer", "", `"1"`),
+				ExpectError: regexp.MustCompile(`width must be set for integer options`),
+			},
+			{
+				Config:      testAccDHCPOptionConfig(150, "integer", `width = 8`, `"256"`),
+				ExpectError: regexp.MustCompile(`Invalid DHCP Option Value`),
+			},
+			{
+				Config:      testAccDHCPOptionConfig(150, "ipaddress", "", `"tftp.example.com"`),
+				ExpectError: regexp.MustCompile(`Invalid IPv4 Address String Value`),
+			},
+			{
+				Config:      testAccDHCPOptionConfig(150, "hexarray", "", `"0x0a"`),
+				ExpectError: regexp.MustCompile(`Invalid DHCP Option Value`),
+			},
+		},
+	})
+}
+
+func TestAccDHCPOptionResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDHCPOptionConfig(150, "ipaddress", "", `"10.0.50.10"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "code", "150"),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "type", "ipaddress"),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "network_values.%", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_dhcp_option.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDHCPOptionConfig(150, "integer", `
+  signed = true
+  width  = 16
+`, `"-300"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "type", "integer"),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "signed", "true"),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "width", "16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDHCPOptionResource_VendorOptions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDHCPOptionConfig(43, "hexarray", "", `"01:04:0a:00:32:0a"`) + `
+resource "unifi_dhcp_option" "tftp_server" {
+  name = "tftp-server"
+  code = 66
+  type = "text"
+
+  network_values = {
+    (unifi_network.test.id) = "tftp.example.com"
+  }
+}
+
+resource "unifi_dhcp_option" "boot_filename" {
+  name = "boot-filename"
+  code = 67
+  type = "text"
+
+  network_values = {
+    (unifi_network.test.id) = "pxelinux.0"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dhcp_option.test", "code", "43"),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.tftp_server", "code", "66"),
+					resource.TestCheckResourceAttr("unifi_dhcp_option.boot_filename", "code", "67"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDHCPOptionConfig(code int, optionType, settings, value string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_network" "test" {
+  name    = "Test Network"
+  subnet  = "10.0.50.1/24"
+  vlan_id = 50
+}
+
+resource "unifi_dhcp_option" "test" {
+  name = "test-option"
+  code = %d
+  type = %q
+  %s
+
+  network_values = {
+    (unifi_network.test.id) = %s
+  }
+}
+`, code, optionType, settings, value)
+}
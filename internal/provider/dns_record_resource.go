@@ -0,0 +1,441 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+	"slices"
+)
+
+const (
+	dnsRecordTypeA     = "A"
+	dnsRecordTypeAAAA  = "AAAA"
+	dnsRecordTypeCNAME = "CNAME"
+	dnsRecordTypeMX    = "MX"
+	dnsRecordTypeSRV   = "SRV"
+	dnsRecordTypeTXT   = "TXT"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &DNSRecordResource{}
+	_ resource.ResourceWithImportState    = &DNSRecordResource{}
+	_ resource.ResourceWithValidateConfig = &DNSRecordResource{}
+
+	defaultDNSRecordResourceModel = DNSRecordResourceModel{}
+
+	// dnsNameRegexp matches a domain name, allowing the leading underscore labels used by SRV records.
+	dnsNameRegexp = regexp.MustCompile(`^(_?[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*_?[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.?$`)
+)
+
+func NewDNSRecordResource() resource.Resource {
+	return &DNSRecordResource{}
+}
+
+// DNSRecordResource defines the resource implementation.
+type DNSRecordResource struct {
+	client *unifiClient
+}
+
+func (r *DNSRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultDNSRecordResourceModel.schema()
+}
+
+func (r *DNSRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSRecordResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate(ctx)...)
+}
+
+func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	record := &dnsRecord{}
+	data.toDNSRecord(record)
+
+	record, err := r.client.createDNSRecord(ctx, site, record)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS record, got error: %s", err))
+		return
+	}
+
+	data = newDNSRecordResourceModel(record, site, data)
+
+	tflog.Trace(ctx, "DNS record created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	record, err := r.client.getDNSRecord(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS record, got error: %s", err))
+		return
+	}
+
+	data = newDNSRecordResourceModel(record, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current record so settings that aren't managed by the resource are left untouched.
+	record, err := r.client.getDNSRecord(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS record, got error: %s", err))
+		return
+	}
+
+	data.toDNSRecord(record)
+
+	record, err = r.client.updateDNSRecord(ctx, site, record)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS record, got error: %s", err))
+		return
+	}
+
+	data = newDNSRecordResourceModel(record, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.deleteDNSRecord(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS record, got error: %s", err))
+		return
+	}
+}
+
+func (r *DNSRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type DNSRecordResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Name     types.String `tfsdk:"name"`
+	Port     types.Int32  `tfsdk:"port"`
+	Priority types.Int32  `tfsdk:"priority"`
+	Site     types.String `tfsdk:"site"`
+	TTL      types.Int32  `tfsdk:"ttl"`
+	Type     types.String `tfsdk:"type"`
+	Value    types.String `tfsdk:"value"`
+	Weight   types.Int32  `tfsdk:"weight"`
+}
+
+func (m *DNSRecordResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A static DNS record served by the gateway to clients that use it for DNS.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi DNS record identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "When false, the controller doesn't serve the record. Default: `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The domain name of the record, e.g. `nas.home.example.com`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(253),
+					stringvalidator.RegexMatches(dnsNameRegexp, "must be a domain name"),
+				},
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The port of the service. Required for, and only valid with, `SRV` records.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"priority": schema.Int32Attribute{
+				MarkdownDescription: "The priority of the mail server or service, lower is preferred. Required for, " +
+					"and only valid with, `MX` and `SRV` records.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 65535),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the DNS record belongs to. Setting this overrides the default site set " +
+					"in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int32Attribute{
+				MarkdownDescription: "The time to live of the record in seconds. `0` uses the gateway's default. " +
+					"Default: `0`",
+				Computed: true,
+				Optional: true,
+				Default:  int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.Between(0, 604800),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the record. One of `A`, `AAAA`, `CNAME`, `MX`, `SRV` or `TXT`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						dnsRecordTypeA,
+						dnsRecordTypeAAAA,
+						dnsRecordTypeCNAME,
+						dnsRecordTypeMX,
+						dnsRecordTypeSRV,
+						dnsRecordTypeTXT,
+					),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the record. An IPv4 address for `A` records, an IPv6 address for " +
+					"`AAAA` records, the domain name of the target for `CNAME`, `MX` and `SRV` records, and any text " +
+					"for `TXT` records.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"weight": schema.Int32Attribute{
+				MarkdownDescription: "The weight of the service relative to others with the same priority. Required " +
+					"for, and only valid with, `SRV` records.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 65535),
+				},
+			},
+		},
+	}
+}
+
+// validate checks the value is valid for the type of record, and the type specific attributes are only set for the
+// types that use them.
+func (m *DNSRecordResourceModel) validate(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.Type.IsUnknown() || m.Type.IsNull() {
+		return diags
+	}
+
+	recordType := m.Type.ValueString()
+	if !m.Value.IsUnknown() && !m.Value.IsNull() {
+		value, p := m.Value.ValueString(), path.Root("value")
+		switch recordType {
+		case dnsRecordTypeA:
+			diags.Append(iptypes.IPv4AddressType{}.Validate(ctx, tftypes.NewValue(tftypes.String, value), p)...)
+		case dnsRecordTypeAAAA:
+			diags.Append(iptypes.IPv6AddressType{}.Validate(ctx, tftypes.NewValue(tftypes.String, value), p)...)
+		case dnsRecordTypeCNAME, dnsRecordTypeMX, dnsRecordTypeSRV:
+			if !dnsNameRegexp.MatchString(value) {
+				diags.AddAttributeError(
+					p,
+					"Invalid DNS Record Value",
+					fmt.Sprintf("The value of a %s record must be a domain name, got: %s.", recordType, value),
+				)
+			}
+		}
+	}
+
+	attributes := []struct {
+		name  string
+		value types.Int32
+		types []string
+	}{
+		{"port", m.Port, []string{dnsRecordTypeSRV}},
+		{"priority", m.Priority, []string{dnsRecordTypeMX, dnsRecordTypeSRV}},
+		{"weight", m.Weight, []string{dnsRecordTypeSRV}},
+	}
+
+	for _, attribute := range attributes {
+		if attribute.value.IsUnknown() {
+			continue
+		}
+
+		used := slices.Contains(attribute.types, recordType)
+		switch {
+		case used && attribute.value.IsNull():
+			diags.AddAttributeError(
+				path.Root(attribute.name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s must be set for %s records.", attribute.name, recordType),
+			)
+		case !used && !attribute.value.IsNull():
+			diags.AddAttributeError(
+				path.Root(attribute.name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s can't be set for %s records.", attribute.name, recordType),
+			)
+		}
+	}
+
+	return diags
+}
+
+func (m *DNSRecordResourceModel) toDNSRecord(record *dnsRecord) {
+	record.Enabled = m.Enabled.ValueBool()
+	record.Key = m.Name.ValueString()
+	record.RecordType = m.Type.ValueString()
+	record.TTL = int64(m.TTL.ValueInt32())
+	record.Value = m.Value.ValueString()
+
+	record.Port = utils.Int32ToInt64Ptr(m.Port)
+	record.Priority = utils.Int32ToInt64Ptr(m.Priority)
+	record.Weight = utils.Int32ToInt64Ptr(m.Weight)
+}
+
+func newDNSRecordResourceModel(record *dnsRecord, site string, model DNSRecordResourceModel) DNSRecordResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(record.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.Enabled = types.BoolValue(record.Enabled)
+	model.Name = types.StringValue(record.Key)
+	model.TTL = types.Int32Value(int32(record.TTL))
+	model.Type = types.StringValue(record.RecordType)
+	model.Value = types.StringValue(record.Value)
+
+	// The controller returns zeros for the attributes the record type doesn't use.
+	model.Port, model.Priority, model.Weight = types.Int32Null(), types.Int32Null(), types.Int32Null()
+	switch record.RecordType {
+	case dnsRecordTypeMX:
+		model.Priority = utils.Int64PtrToInt32(record.Priority)
+	case dnsRecordTypeSRV:
+		model.Port = utils.Int64PtrToInt32(record.Port)
+		model.Priority = utils.Int64PtrToInt32(record.Priority)
+		model.Weight = utils.Int64PtrToInt32(record.Weight)
+	}
+
+	return model
+}
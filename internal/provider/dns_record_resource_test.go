@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDNSRecordResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDNSRecordConfig("A", "2001:db8::1", ""),
+				ExpectError: regexp.MustCompile(`Invalid IPv4 Address String Value`),
+			},
+			{
+				Config:      testAccDNSRecordConfig("AAAA", "192.168.1.10", ""),
+				ExpectError: regexp.MustCompile(`Invalid IPv6 Address String Value`),
+			},
+			{
+				Config:      testAccDNSRecordConfig("CNAME", "not a domain", ""),
+				ExpectError: regexp.MustCompile(`Invalid DNS Record Value`),
+			},
+			{
+				Config:      testAccDNSRecordConfig("MX", "mail.example.com", ""),
+				ExpectError: regexp.MustCompile(`priority must be set for MX records`),
+			},
+			{
+				Config:      testAccDNSRecordConfig("A", "192.168.1.10", `port = 80`),
+				ExpectError: regexp.MustCompile(`port can't be set for A records`),
+			},
+		},
+	})
+}
+
+func TestAccDNSRecordResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDNSRecordConfig("A", "192.168.1.10", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dns_record.test", "name", "tfacc.example.com"),
+					resource.TestCheckResourceAttr("unifi_dns_record.test", "type", "A"),
+					resource.TestCheckResourceAttr("unifi_dns_record.test", "value", "192.168.1.10"),
+					resource.TestCheckResourceAttr("unifi_dns_record.test", "ttl", "0"),
+					resource.TestCheckResourceAttr("unifi_dns_record.test", "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_dns_record.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDNSRecordConfig("A", "192.168.1.11", `
+  enabled = false
+  ttl     = 300
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dns_record.test", "value", "192.168.1.11"),
+					resource.TestCheckResourceAttr("unifi_dns_record.test", "ttl", "300"),
+					resource.TestCheckResourceAttr("unifi_dns_record.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSRecordConfig(recordType, value, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_dns_record" "test" {
+  name  = "tfacc.example.com"
+  type  = %q
+  value = %q
+%s
+}
+`, recordType, value, settings)
+}
@@ -0,0 +1,372 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+)
+
+const dynamicDNSServiceCustom = "custom"
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &DynamicDNSResource{}
+	_ resource.ResourceWithImportState    = &DynamicDNSResource{}
+	_ resource.ResourceWithValidateConfig = &DynamicDNSResource{}
+
+	defaultDynamicDNSResourceModel = DynamicDNSResourceModel{}
+
+	dynamicDNSServices = []string{
+		"afraid", "changeip", "cloudflare", "cloudxns", "ddnss", "dhis", "dnsexit", "dnsomatic", "dnspark", "dnspod",
+		"dslreports", "dtdns", "duckdns", "duiadns", "dyn", "dyndns", "dynv6", "easydns", "freemyip", "googledomains",
+		"loopia", "namecheap", "noip", "nsupdate", "ovh", "sitelutions", "spdyn", "strato", "tunnelbroker",
+		"zoneedit", dynamicDNSServiceCustom,
+	}
+
+	// dynamicDNSValueRegexp matches the values the controller accepts for the DDNS settings.
+	dynamicDNSValueRegexp = regexp.MustCompile(`^[^"' ]+$`)
+)
+
+func NewDynamicDNSResource() resource.Resource {
+	return &DynamicDNSResource{}
+}
+
+// DynamicDNSResource defines the resource implementation.
+type DynamicDNSResource struct {
+	client *unifiClient
+}
+
+func (r *DynamicDNSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_dns"
+}
+
+func (r *DynamicDNSResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultDynamicDNSResourceModel.schema()
+}
+
+func (r *DynamicDNSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DynamicDNSResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DynamicDNSResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+func (r *DynamicDNSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DynamicDNSResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	dynamicDNS := &unifi.DynamicDNS{}
+	data.toUnifiDynamicDNS(dynamicDNS)
+
+	dynamicDNS, err := r.client.CreateDynamicDNS(ctx, site, dynamicDNS)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dynamic DNS, got error: %s", err))
+		return
+	}
+
+	data = newDynamicDNSResourceModel(dynamicDNS, site, data)
+
+	tflog.Trace(ctx, "Dynamic DNS created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DynamicDNSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DynamicDNSResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	dynamicDNS, err := r.client.GetDynamicDNS(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dynamic DNS, got error: %s", err))
+		return
+	}
+
+	data = newDynamicDNSResourceModel(dynamicDNS, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DynamicDNSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DynamicDNSResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current entry so settings that aren't managed by the resource are left untouched.
+	dynamicDNS, err := r.client.GetDynamicDNS(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dynamic DNS, got error: %s", err))
+		return
+	}
+
+	data.toUnifiDynamicDNS(dynamicDNS)
+
+	dynamicDNS, err = r.client.UpdateDynamicDNS(ctx, site, dynamicDNS)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dynamic DNS, got error: %s", err))
+		return
+	}
+
+	data = newDynamicDNSResourceModel(dynamicDNS, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DynamicDNSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DynamicDNSResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteDynamicDNS(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dynamic DNS, got error: %s", err))
+		return
+	}
+}
+
+func (r *DynamicDNSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type DynamicDNSResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	CustomService types.String `tfsdk:"custom_service"`
+	Hostname      types.String `tfsdk:"hostname"`
+	Login         types.String `tfsdk:"login"`
+	Password      types.String `tfsdk:"password"`
+	Server        types.String `tfsdk:"server"`
+	Service       types.String `tfsdk:"service"`
+	Site          types.String `tfsdk:"site"`
+	WANInterface  types.String `tfsdk:"wan_interface"`
+}
+
+func (m *DynamicDNSResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A dynamic DNS entry on the gateway, which keeps a hostname pointing at the address of " +
+			"one of its WAN interfaces.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi dynamic DNS identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"custom_service": schema.StringAttribute{
+				MarkdownDescription: "The name of the service, passed to inadyn, when `service` is `custom`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dynamicDNSValueRegexp, "must not contain quotes or spaces"),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname to keep updated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dynamicDNSValueRegexp, "must not contain quotes or spaces"),
+				},
+			},
+			"login": schema.StringAttribute{
+				MarkdownDescription: "The username to log in to the service with.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dynamicDNSValueRegexp, "must not contain quotes or spaces"),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password, or token, to log in to the service with.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dynamicDNSValueRegexp, "must not contain quotes or spaces"),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "The server to send updates to, when the service doesn't use its default.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dynamicDNSValueRegexp, "must not contain quotes or spaces"),
+				},
+			},
+			"service": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dynamicDNSServices...),
+					customvalidator.StringValueWithPaths(dynamicDNSServiceCustom, path.MatchRoot("custom_service")),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the dynamic DNS entry belongs to. Setting this overrides the default " +
+					"site set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wan_interface": schema.StringAttribute{
+				MarkdownDescription: "The WAN interface whose address is published. Default: `wan`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("wan"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("wan", "wan2"),
+				},
+			},
+		},
+	}
+}
+
+func (m *DynamicDNSResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.CustomService.IsNull() && !m.Service.IsUnknown() && m.Service.ValueString() != dynamicDNSServiceCustom {
+		diags.AddAttributeError(
+			path.Root("custom_service"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("custom_service can only be set when service is %q, got: %s.", dynamicDNSServiceCustom, m.Service.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+func (m *DynamicDNSResourceModel) toUnifiDynamicDNS(dynamicDNS *unifi.DynamicDNS) {
+	dynamicDNS.CustomService = m.CustomService.ValueStringPointer()
+	dynamicDNS.HostName = m.Hostname.ValueStringPointer()
+	dynamicDNS.Interface = m.WANInterface.ValueStringPointer()
+	dynamicDNS.Login = m.Login.ValueStringPointer()
+	dynamicDNS.Server = m.Server.ValueString()
+	dynamicDNS.Service = m.Service.ValueStringPointer()
+	dynamicDNS.XPassword = m.Password.ValueStringPointer()
+}
+
+func newDynamicDNSResourceModel(dynamicDNS *unifi.DynamicDNS, site string, model DynamicDNSResourceModel) DynamicDNSResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(dynamicDNS.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(dynamicDNS.SiteID)
+
+	// Configurable Values
+	model.CustomService = utils.EmptyStringNull(dynamicDNS.CustomService)
+	model.Hostname = types.StringPointerValue(dynamicDNS.HostName)
+	model.Login = utils.EmptyStringNull(dynamicDNS.Login)
+	model.Server = utils.EmptyStringNull(&dynamicDNS.Server)
+	model.Service = types.StringPointerValue(dynamicDNS.Service)
+	model.WANInterface = types.StringPointerValue(dynamicDNS.Interface)
+
+	// The password isn't always returned, so keep the configured value when it's missing.
+	if dynamicDNS.XPassword != nil && *dynamicDNS.XPassword != "" || model.Password.IsNull() {
+		model.Password = utils.EmptyStringNull(dynamicDNS.XPassword)
+	}
+
+	return model
+}
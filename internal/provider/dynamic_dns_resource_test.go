@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccDynamicDNSResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDynamicDNSConfig("custom", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccDynamicDNSConfig("duckdns", `custom_service = "default@example.com"`),
+				ExpectError: regexp.MustCompile(`custom_service can only be set when service is "custom"`),
+			},
+			{
+				Config:      testAccDynamicDNSConfig("duckdns", `wan_interface = "wan3"`),
+				ExpectError: regexp.MustCompile(`Attribute wan_interface value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccDynamicDNSResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDynamicDNSConfig("duckdns", `password = "test-token"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dynamic_dns.test", "service", "duckdns"),
+					resource.TestCheckResourceAttr("unifi_dynamic_dns.test", "hostname", "test.duckdns.org"),
+					resource.TestCheckResourceAttr("unifi_dynamic_dns.test", "wan_interface", "wan"),
+					resource.TestCheckNoResourceAttr("unifi_dynamic_dns.test", "login"),
+					resource.TestCheckNoResourceAttr("unifi_dynamic_dns.test", "server"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_dynamic_dns.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccDynamicDNSConfig("dyndns", `
+  login         = "test"
+  password      = "test-password"
+  server        = "members.dyndns.org"
+  wan_interface = "wan2"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_dynamic_dns.test", "service", "dyndns"),
+					resource.TestCheckResourceAttr("unifi_dynamic_dns.test", "login", "test"),
+					resource.TestCheckResourceAttr("unifi_dynamic_dns.test", "server", "members.dyndns.org"),
+					resource.TestCheckResourceAttr("unifi_dynamic_dns.test", "wan_interface", "wan2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDynamicDNSConfig(service, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_dynamic_dns" "test" {
+  service  = %q
+  hostname = "test.duckdns.org"
+  %s
+}
+`, service, settings)
+}
@@ -0,0 +1,444 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"strconv"
+	"strings"
+)
+
+const (
+	firewallGroupTypeAddress     = "address-group"
+	firewallGroupTypeIPv6Address = "ipv6-address-group"
+	firewallGroupTypePort        = "port-group"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &FirewallGroupResource{}
+	_ resource.ResourceWithImportState    = &FirewallGroupResource{}
+	_ resource.ResourceWithValidateConfig = &FirewallGroupResource{}
+
+	defaultFirewallGroupResourceModel = FirewallGroupResourceModel{}
+)
+
+func NewFirewallGroupResource() resource.Resource {
+	return &FirewallGroupResource{}
+}
+
+// FirewallGroupResource defines the resource implementation.
+type FirewallGroupResource struct {
+	client *unifiClient
+}
+
+func (r *FirewallGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_group"
+}
+
+func (r *FirewallGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultFirewallGroupResourceModel.schema()
+}
+
+func (r *FirewallGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FirewallGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate(ctx)...)
+}
+
+func (r *FirewallGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	group := &unifi.FirewallGroup{}
+	resp.Diagnostics.Append(data.toUnifiFirewallGroup(ctx, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.CreateFirewallGroup(ctx, site, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create firewall group, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallGroupResourceModel(ctx, group, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "Firewall group created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	group, err := r.client.GetFirewallGroup(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall group, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallGroupResourceModel(ctx, group, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	group, err := r.client.GetFirewallGroup(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall group, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiFirewallGroup(ctx, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err = r.client.UpdateFirewallGroup(ctx, site, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall group, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallGroupResourceModel(ctx, group, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteFirewallGroup(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall group, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type FirewallGroupResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	Members types.Set    `tfsdk:"members"`
+	Name    types.String `tfsdk:"name"`
+	Site    types.String `tfsdk:"site"`
+	Type    types.String `tfsdk:"type"`
+}
+
+func (m *FirewallGroupResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi firewall group. Groups collect addresses or ports so they can be referenced by " +
+			"firewall rules and port forwards.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi firewall group identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"members": schema.SetAttribute{
+				MarkdownDescription: "The members of the group. For `address-group` these are IPv4 addresses, CIDRs " +
+					"or address ranges such as `192.168.1.10-192.168.1.20`. For `ipv6-address-group` they are IPv6 " +
+					"addresses or CIDRs. For `port-group` they are ports or port ranges such as `8000-8080`.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the firewall group belongs to. Setting this overrides the default " +
+					"site set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of group. One of `address-group`, `ipv6-address-group` or `port-group`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(firewallGroupTypeAddress, firewallGroupTypeIPv6Address, firewallGroupTypePort),
+				},
+			},
+		},
+	}
+}
+
+// validate checks each member is valid for the type of group.
+func (m *FirewallGroupResourceModel) validate(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.Type.IsUnknown() || m.Members.IsUnknown() {
+		return diags
+	}
+
+	for _, element := range m.Members.Elements() {
+		member, ok := element.(types.String)
+		if !ok || member.IsNull() || member.IsUnknown() {
+			continue
+		}
+
+		p := path.Root("members").AtSetValue(member)
+		value := member.ValueString()
+
+		switch m.Type.ValueString() {
+		case firewallGroupTypeAddress:
+			diags.Append(validateFirewallGroupAddress(ctx, p, value)...)
+		case firewallGroupTypeIPv6Address:
+			diags.Append(validateFirewallGroupIPv6Address(ctx, p, value)...)
+		case firewallGroupTypePort:
+			diags.Append(validateFirewallGroupPort(p, value)...)
+		}
+	}
+
+	return diags
+}
+
+func (m *FirewallGroupResourceModel) toUnifiFirewallGroup(ctx context.Context, group *unifi.FirewallGroup) diag.Diagnostics {
+	var members []string
+	diags := m.Members.ElementsAs(ctx, &members, false)
+
+	group.GroupMembers = &members
+	group.GroupType = m.Type.ValueStringPointer()
+	group.Name = m.Name.ValueStringPointer()
+
+	return diags
+}
+
+func newFirewallGroupResourceModel(ctx context.Context, group *unifi.FirewallGroup, site string, model FirewallGroupResourceModel) (FirewallGroupResourceModel, diag.Diagnostics) {
+	// Computed values
+	model.ID = types.StringPointerValue(group.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(group.SiteID)
+
+	// Configurable Values
+	model.Name = types.StringPointerValue(group.Name)
+	model.Type = types.StringPointerValue(group.GroupType)
+
+	members := []string{}
+	if group.GroupMembers != nil {
+		members = *group.GroupMembers
+	}
+
+	var diags diag.Diagnostics
+	model.Members, diags = types.SetValueFrom(ctx, types.StringType, members)
+
+	return model, diags
+}
+
+// validateFirewallGroupAddress checks the member is an IPv4 address, CIDR or a range of addresses.
+func validateFirewallGroupAddress(ctx context.Context, p path.Path, member string) diag.Diagnostics {
+	if strings.Contains(member, "/") {
+		return cidrtypes.IPv4PrefixType{}.Validate(ctx, tftypes.NewValue(tftypes.String, member), p)
+	}
+
+	if start, end, ok := strings.Cut(member, "-"); ok {
+		var diags diag.Diagnostics
+		diags.Append(iptypes.IPv4AddressType{}.Validate(ctx, tftypes.NewValue(tftypes.String, start), p)...)
+		diags.Append(iptypes.IPv4AddressType{}.Validate(ctx, tftypes.NewValue(tftypes.String, end), p)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		startAddr, _ := iptypes.NewIPv4AddressValue(start).ValueIPv4Address()
+		endAddr, _ := iptypes.NewIPv4AddressValue(end).ValueIPv4Address()
+		if endAddr.Less(startAddr) {
+			diags.AddAttributeError(
+				p,
+				"Invalid Firewall Group Member",
+				fmt.Sprintf("The end of the address range %q must not be before the start.", member),
+			)
+		}
+
+		return diags
+	}
+
+	return iptypes.IPv4AddressType{}.Validate(ctx, tftypes.NewValue(tftypes.String, member), p)
+}
+
+// validateFirewallGroupIPv6Address checks the member is an IPv6 address or CIDR.
+func validateFirewallGroupIPv6Address(ctx context.Context, p path.Path, member string) diag.Diagnostics {
+	if strings.Contains(member, "/") {
+		return cidrtypes.IPv6PrefixType{}.Validate(ctx, tftypes.NewValue(tftypes.String, member), p)
+	}
+
+	return iptypes.IPv6AddressType{}.Validate(ctx, tftypes.NewValue(tftypes.String, member), p)
+}
+
+// validateFirewallGroupPort checks the member is a port or a range of ports.
+func validateFirewallGroupPort(p path.Path, member string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	start, end, ok := parsePortRange(member)
+	if !ok {
+		diags.AddAttributeError(
+			p,
+			"Invalid Firewall Group Member",
+			fmt.Sprintf("Port group members must be a port or port range between 1 and 65535, got: %q.", member),
+		)
+
+		return diags
+	}
+
+	if end < start {
+		diags.AddAttributeError(
+			p,
+			"Invalid Firewall Group Member",
+			fmt.Sprintf("The end of the port range %q must not be before the start.", member),
+		)
+	}
+
+	return diags
+}
+
+// parsePortRange parses a port, e.g. `80`, or a range of ports, e.g. `8000-8080`. A single port is returned as a range
+// that starts and ends on the port. ok is false when either port isn't a number between 1 and 65535.
+func parsePortRange(value string) (start, end int, ok bool) {
+	startValue, endValue, isRange := strings.Cut(value, "-")
+	if !isRange {
+		endValue = startValue
+	}
+
+	start, startErr := strconv.Atoi(startValue)
+	end, endErr := strconv.Atoi(endValue)
+	if startErr != nil || endErr != nil || start < 1 || start > 65535 || end < 1 || end > 65535 {
+		return 0, 0, false
+	}
+
+	return start, end, true
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccFirewallGroupResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallGroupConfig("address-group", `["192.168.1.10", "2001:db8::1"]`),
+				ExpectError: regexp.MustCompile(`Invalid IPv4 Address String Value`),
+			},
+			{
+				Config:      testAccFirewallGroupConfig("address-group", `["192.168.1.20-192.168.1.10"]`),
+				ExpectError: regexp.MustCompile(`must not be before the start`),
+			},
+			{
+				Config:      testAccFirewallGroupConfig("ipv6-address-group", `["192.168.1.0/24"]`),
+				ExpectError: regexp.MustCompile(`Invalid IPv6 CIDR String Value`),
+			},
+			{
+				Config:      testAccFirewallGroupConfig("port-group", `["80", "70000"]`),
+				ExpectError: regexp.MustCompile(`must be a port or port range between 1 and 65535`),
+			},
+		},
+	})
+}
+
+func TestAccFirewallGroupResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallGroupConfig("address-group", `["192.168.1.10", "10.0.0.0/24"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_group.test", "name", "Test Group"),
+					resource.TestCheckResourceAttr("unifi_firewall_group.test", "type", "address-group"),
+					resource.TestCheckResourceAttr("unifi_firewall_group.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemAttr("unifi_firewall_group.test", "members.*", "10.0.0.0/24"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_firewall_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallGroupConfig("address-group", `["192.168.1.10-192.168.1.20"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_group.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unifi_firewall_group.test", "members.*", "192.168.1.10-192.168.1.20"),
+				),
+			},
+			// Replace with a port group
+			{
+				Config: testAccFirewallGroupConfig("port-group", `["80", "443", "8000-8080"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_group.test", "type", "port-group"),
+					resource.TestCheckResourceAttr("unifi_firewall_group.test", "members.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallGroupConfig(groupType, members string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_firewall_group" "test" {
+  name    = "Test Group"
+  type    = %q
+  members = %s
+}
+`, groupType, members)
+}
@@ -0,0 +1,817 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customtype"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"strings"
+)
+
+const (
+	firewallPolicyActionAllow = "ALLOW"
+
+	firewallPolicyMatchingTargetAny         = "ANY"
+	firewallPolicyMatchingTargetApp         = "APP"
+	firewallPolicyMatchingTargetAppCategory = "APP_CATEGORY"
+	firewallPolicyMatchingTargetClient      = "CLIENT"
+	firewallPolicyMatchingTargetIP          = "IP"
+	firewallPolicyMatchingTargetNetwork     = "NETWORK"
+	firewallPolicyMatchingTargetRegion      = "REGION"
+	firewallPolicyMatchingTargetWeb         = "WEB"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &FirewallPolicyResource{}
+	_ resource.ResourceWithImportState    = &FirewallPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &FirewallPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &FirewallPolicyResource{}
+
+	defaultFirewallPolicyResourceModel            = FirewallPolicyResourceModel{}
+	defaultFirewallPolicyDestinationResourceModel = FirewallPolicyDestinationResourceModel{}
+	defaultFirewallPolicySourceResourceModel      = FirewallPolicySourceResourceModel{}
+)
+
+func NewFirewallPolicyResource() resource.Resource {
+	return &FirewallPolicyResource{}
+}
+
+// FirewallPolicyResource defines the resource implementation.
+type FirewallPolicyResource struct {
+	client *unifiClient
+}
+
+func (r *FirewallPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_policy"
+}
+
+func (r *FirewallPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultFirewallPolicyResourceModel.schema()
+}
+
+func (r *FirewallPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	// Detect which firewall the default site uses up front. See detectZoneBasedFirewall.
+	detectZoneBasedFirewall(ctx, client)
+}
+
+func (r *FirewallPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FirewallPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+// ModifyPlan checks the site uses zone-based firewalling, which policies are part of.
+func (r *FirewallPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or when the provider hasn't been configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan FirewallPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if plan.Site.ValueString() != "" {
+		site = plan.Site.ValueString()
+	}
+
+	resp.Diagnostics.Append(checkZoneBasedFirewall(ctx, r.client, site, true, "unifi_firewall_policy")...)
+}
+
+func (r *FirewallPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	policy := &firewallPolicy{}
+	resp.Diagnostics.Append(data.toFirewallPolicy(ctx, policy)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.createFirewallPolicy(ctx, site, policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create firewall policy, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallPolicyResourceModel(ctx, policy, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Firewall policy created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	policy, err := r.client.getFirewallPolicy(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall policy, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallPolicyResourceModel(ctx, policy, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current policy so settings that aren't managed by the resource are left untouched.
+	policy, err := r.client.getFirewallPolicy(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall policy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toFirewallPolicy(ctx, policy)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err = r.client.updateFirewallPolicy(ctx, site, policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall policy, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallPolicyResourceModel(ctx, policy, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.deleteFirewallPolicy(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall policy, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type FirewallPolicyResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	Action             types.String                            `tfsdk:"action"`
+	ConnectionStates   types.Set                               `tfsdk:"connection_states"`
+	CreateAllowRespond types.Bool                              `tfsdk:"create_allow_respond"`
+	Description        types.String                            `tfsdk:"description"`
+	Destination        *FirewallPolicyDestinationResourceModel `tfsdk:"destination"`
+	Enabled            types.Bool                              `tfsdk:"enabled"`
+	ICMPTypeName       types.String                            `tfsdk:"icmp_type_name"`
+	Index              types.Int32                             `tfsdk:"index"`
+	IPVersion          types.String                            `tfsdk:"ip_version"`
+	Logging            types.Bool                              `tfsdk:"logging"`
+	Name               types.String                            `tfsdk:"name"`
+	Protocol           types.String                            `tfsdk:"protocol"`
+	Schedule           *TrafficRuleScheduleResourceModel       `tfsdk:"schedule"`
+	Site               types.String                            `tfsdk:"site"`
+	Source             *FirewallPolicySourceResourceModel      `tfsdk:"source"`
+}
+
+func (m *FirewallPolicyResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A firewall policy, which allows, blocks or rejects traffic from one firewall zone to " +
+			"another. This is for sites that use zone-based firewalling, available from Network 9. Policies for a " +
+			"site still on the legacy firewall fail when planning.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi firewall policy identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"action": schema.StringAttribute{
+				MarkdownDescription: "What to do with matching traffic. One of `ALLOW`, `BLOCK` or `REJECT`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(firewallPolicyActionAllow, "BLOCK", "REJECT"),
+				},
+			},
+			"connection_states": schema.SetAttribute{
+				MarkdownDescription: "Only match traffic in these connection states. Any of `ESTABLISHED`, `INVALID`, " +
+					"`NEW` and `RELATED`. When not set traffic in any state is matched.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("ESTABLISHED", "INVALID", "NEW", "RELATED")),
+				},
+			},
+			"create_allow_respond": schema.BoolAttribute{
+				MarkdownDescription: "When true, return traffic for connections allowed by the policy is also " +
+					"allowed. Only valid when `action` is `ALLOW`.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"destination": defaultFirewallPolicyDestinationResourceModel.schema(),
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"icmp_type_name": schema.StringAttribute{
+				MarkdownDescription: "The ICMP, or ICMPv6 when `ip_version` is `IPV6`, type to match, e.g. " +
+					"`echo-request`. Only valid when `protocol` is `icmp` or `icmpv6`.",
+				Optional: true,
+			},
+			"index": schema.Int32Attribute{
+				MarkdownDescription: "The position of the policy amongst the policies between the same zones. " +
+					"Policies with a lower index are evaluated first. When not set the controller picks one.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"ip_version": schema.StringAttribute{
+				MarkdownDescription: "The IP version of the traffic to match. One of `BOTH`, `IPV4` or `IPV6`. " +
+					"Default: `BOTH`",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString("BOTH"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("BOTH", "IPV4", "IPV6"),
+				},
+			},
+			"logging": schema.BoolAttribute{
+				MarkdownDescription: "When true, traffic matching the policy is logged.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol to match, e.g. `tcp`, `udp`, `tcp_udp`, `icmp` or `icmpv6`. " +
+					"Default: `all`",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString("all"),
+			},
+			"schedule": defaultTrafficRuleScheduleResourceModel.schema(),
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the firewall policy belongs to. Setting this overrides the default " +
+					"site set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": defaultFirewallPolicySourceResourceModel.schema(),
+		},
+	}
+}
+
+// validate checks for combinations the controller rejects, so they are reported at plan time with an explanation.
+func (m *FirewallPolicyResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.CreateAllowRespond.ValueBool() && !m.Action.IsUnknown() && m.Action.ValueString() != firewallPolicyActionAllow {
+		diags.AddAttributeError(
+			path.Root("create_allow_respond"),
+			"Invalid Firewall Policy",
+			fmt.Sprintf("Return traffic can only be allowed when action is ALLOW, got: %s.", m.Action.ValueString()),
+		)
+	}
+
+	protocol := m.Protocol.ValueString()
+	if m.Protocol.IsNull() {
+		protocol = "all"
+	}
+
+	if !m.ICMPTypeName.IsNull() && !m.Protocol.IsUnknown() && protocol != "icmp" && protocol != "icmpv6" {
+		diags.AddAttributeError(
+			path.Root("icmp_type_name"),
+			"Invalid Firewall Policy",
+			fmt.Sprintf("An ICMP type can only be matched when protocol is icmp or icmpv6, got: %s.", protocol),
+		)
+	}
+
+	portsAllowed := protocol == "tcp" || protocol == "udp" || protocol == "tcp_udp"
+	ports := map[string]types.String{}
+	if m.Source != nil {
+		ports["source"] = m.Source.Port
+	}
+
+	if m.Destination != nil {
+		ports["destination"] = m.Destination.Port
+	}
+
+	for name, port := range ports {
+		if port.IsNull() || m.Protocol.IsUnknown() || portsAllowed {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root(name).AtName("port"),
+			"Invalid Firewall Policy",
+			fmt.Sprintf("Ports can only be matched when protocol is tcp, udp or tcp_udp, got: %s.", protocol),
+		)
+	}
+
+	return diags
+}
+
+func (m *FirewallPolicyResourceModel) toFirewallPolicy(ctx context.Context, policy *firewallPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy.Action = m.Action.ValueString()
+	policy.CreateAllowRespond = m.CreateAllowRespond.ValueBool()
+	policy.Description = m.Description.ValueString()
+	policy.Enabled = m.Enabled.ValueBool()
+	policy.IPVersion = m.IPVersion.ValueString()
+	policy.Logging = m.Logging.ValueBool()
+	policy.Name = m.Name.ValueString()
+	policy.Protocol = m.Protocol.ValueString()
+
+	if !m.Index.IsNull() && !m.Index.IsUnknown() {
+		index := int64(m.Index.ValueInt32())
+		policy.Index = &index
+	}
+
+	policy.ConnectionStateType = "ALL"
+	policy.ConnectionStates = make([]string, 0)
+	if !m.ConnectionStates.IsNull() {
+		policy.ConnectionStateType = "CUSTOM"
+		diags.Append(utils.SetValueStrings(ctx, m.ConnectionStates, &policy.ConnectionStates)...)
+	}
+
+	policy.ICMPTypeName, policy.ICMPv6TypeName = "ANY", "ANY"
+	if !m.ICMPTypeName.IsNull() {
+		if m.Protocol.ValueString() == "icmpv6" {
+			policy.ICMPv6TypeName = m.ICMPTypeName.ValueString()
+		} else {
+			policy.ICMPTypeName = m.ICMPTypeName.ValueString()
+		}
+	}
+
+	diags.Append(m.Source.toFirewallPolicyEndpoint(ctx, &policy.Source)...)
+	diags.Append(m.Destination.toFirewallPolicyEndpoint(ctx, &policy.Destination)...)
+	diags.Append(m.Schedule.toTrafficSchedule(ctx, &policy.Schedule)...)
+
+	return diags
+}
+
+func newFirewallPolicyResourceModel(ctx context.Context, policy *firewallPolicy, site string, model FirewallPolicyResourceModel) (FirewallPolicyResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(policy.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.Action = types.StringValue(policy.Action)
+	model.CreateAllowRespond = types.BoolValue(policy.CreateAllowRespond)
+	model.Description = utils.EmptyStringNull(&policy.Description)
+	model.Enabled = types.BoolValue(policy.Enabled)
+	model.IPVersion = types.StringValue(policy.IPVersion)
+	model.Logging = types.BoolValue(policy.Logging)
+	model.Name = types.StringValue(policy.Name)
+	model.Protocol = types.StringValue(policy.Protocol)
+
+	model.Index = types.Int32Null()
+	if policy.Index != nil {
+		model.Index = types.Int32Value(int32(*policy.Index))
+	}
+
+	states := policy.ConnectionStates
+	if policy.ConnectionStateType != "CUSTOM" {
+		states = nil
+	}
+
+	var d diag.Diagnostics
+	model.ConnectionStates, d = utils.StringSetValue(ctx, states)
+	diags.Append(d...)
+
+	icmpTypeName := policy.ICMPTypeName
+	if policy.Protocol == "icmpv6" {
+		icmpTypeName = policy.ICMPv6TypeName
+	}
+
+	model.ICMPTypeName = types.StringNull()
+	if icmpTypeName != "" && icmpTypeName != "ANY" {
+		model.ICMPTypeName = types.StringValue(icmpTypeName)
+	}
+
+	model.Source, d = newFirewallPolicySourceResourceModel(ctx, policy.Source)
+	diags.Append(d...)
+	model.Destination, d = newFirewallPolicyDestinationResourceModel(ctx, policy.Destination)
+	diags.Append(d...)
+	model.Schedule, d = newTrafficRuleScheduleResourceModel(ctx, policy.Schedule)
+	diags.Append(d...)
+
+	return model, diags
+}
+
+type FirewallPolicySourceResourceModel struct {
+	ClientMACs         types.Set    `tfsdk:"client_macs"`
+	IPs                types.Set    `tfsdk:"ips"`
+	MatchOppositeIPs   types.Bool   `tfsdk:"match_opposite_ips"`
+	MatchOppositePorts types.Bool   `tfsdk:"match_opposite_ports"`
+	MatchingTarget     types.String `tfsdk:"matching_target"`
+	NetworkIDs         types.Set    `tfsdk:"network_ids"`
+	Port               types.String `tfsdk:"port"`
+	Regions            types.Set    `tfsdk:"regions"`
+	ZoneID             types.String `tfsdk:"zone_id"`
+}
+
+func (m *FirewallPolicySourceResourceModel) schema() schema.Attribute {
+	attributes := firewallPolicyEndpointAttributes(
+		[]string{firewallPolicyMatchingTargetClient},
+		customvalidator.StringValueWithPaths(firewallPolicyMatchingTargetClient, path.MatchRelative().AtParent().AtName("client_macs")),
+	)
+	attributes["client_macs"] = schema.SetAttribute{
+		MarkdownDescription: "The clients to match when `matching_target` is `CLIENT`.",
+		ElementType:         customtype.MacType{},
+		Optional:            true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The zone, and optionally the addresses and ports within it, traffic comes from.",
+		Required:            true,
+		Attributes:          attributes,
+	}
+}
+
+func (m *FirewallPolicySourceResourceModel) toFirewallPolicyEndpoint(ctx context.Context, endpoint *firewallPolicyEndpoint) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	setFirewallPolicyEndpoint(endpoint, m.ZoneID, m.MatchingTarget, m.Port, m.MatchOppositeIPs, m.MatchOppositePorts)
+
+	endpoint.ClientMACs, endpoint.IPs, endpoint.NetworkIDs, endpoint.Regions = nil, nil, nil, nil
+	diags.Append(utils.SetValueStrings(ctx, m.ClientMACs, &endpoint.ClientMACs)...)
+	diags.Append(utils.SetValueStrings(ctx, m.IPs, &endpoint.IPs)...)
+	diags.Append(utils.SetValueStrings(ctx, m.NetworkIDs, &endpoint.NetworkIDs)...)
+	diags.Append(utils.SetValueStrings(ctx, m.Regions, &endpoint.Regions)...)
+
+	return diags
+}
+
+func newFirewallPolicySourceResourceModel(ctx context.Context, endpoint firewallPolicyEndpoint) (*FirewallPolicySourceResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := &FirewallPolicySourceResourceModel{
+		ClientMACs:         types.SetNull(customtype.MacType{}),
+		MatchOppositeIPs:   types.BoolValue(endpoint.MatchOppositeIPs),
+		MatchOppositePorts: types.BoolValue(endpoint.MatchOppositePorts),
+		MatchingTarget:     types.StringValue(endpoint.MatchingTarget),
+		Port:               utils.EmptyStringNull(&endpoint.Port),
+		ZoneID:             types.StringValue(endpoint.ZoneID),
+	}
+
+	if len(endpoint.ClientMACs) > 0 {
+		model.ClientMACs, diags = types.SetValueFrom(ctx, customtype.MacType{}, endpoint.ClientMACs)
+	}
+
+	var d diag.Diagnostics
+	model.IPs, d = utils.StringSetValue(ctx, endpoint.IPs)
+	diags.Append(d...)
+	model.NetworkIDs, d = utils.StringSetValue(ctx, endpoint.NetworkIDs)
+	diags.Append(d...)
+	model.Regions, d = utils.StringSetValue(ctx, endpoint.Regions)
+	diags.Append(d...)
+
+	return model, diags
+}
+
+type FirewallPolicyDestinationResourceModel struct {
+	AppCategoryIDs     types.Set    `tfsdk:"app_category_ids"`
+	AppIDs             types.Set    `tfsdk:"app_ids"`
+	IPs                types.Set    `tfsdk:"ips"`
+	MatchOppositeIPs   types.Bool   `tfsdk:"match_opposite_ips"`
+	MatchOppositePorts types.Bool   `tfsdk:"match_opposite_ports"`
+	MatchingTarget     types.String `tfsdk:"matching_target"`
+	NetworkIDs         types.Set    `tfsdk:"network_ids"`
+	Port               types.String `tfsdk:"port"`
+	Regions            types.Set    `tfsdk:"regions"`
+	WebDomains         types.Set    `tfsdk:"web_domains"`
+	ZoneID             types.String `tfsdk:"zone_id"`
+}
+
+func (m *FirewallPolicyDestinationResourceModel) schema() schema.Attribute {
+	attributes := firewallPolicyEndpointAttributes(
+		[]string{firewallPolicyMatchingTargetApp, firewallPolicyMatchingTargetAppCategory, firewallPolicyMatchingTargetWeb},
+		customvalidator.StringValueWithPaths(firewallPolicyMatchingTargetApp, path.MatchRelative().AtParent().AtName("app_ids")),
+		customvalidator.StringValueWithPaths(firewallPolicyMatchingTargetAppCategory, path.MatchRelative().AtParent().AtName("app_category_ids")),
+		customvalidator.StringValueWithPaths(firewallPolicyMatchingTargetWeb, path.MatchRelative().AtParent().AtName("web_domains")),
+	)
+	attributes["app_category_ids"] = schema.SetAttribute{
+		MarkdownDescription: "The DPI app categories to match when `matching_target` is `APP_CATEGORY`.",
+		ElementType:         types.Int64Type,
+		Optional:            true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+	attributes["app_ids"] = schema.SetAttribute{
+		MarkdownDescription: "The DPI apps to match when `matching_target` is `APP`.",
+		ElementType:         types.Int64Type,
+		Optional:            true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+	attributes["web_domains"] = schema.SetAttribute{
+		MarkdownDescription: "The domains to match when `matching_target` is `WEB`.",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The zone, and optionally the addresses, apps and ports within it, traffic goes to.",
+		Required:            true,
+		Attributes:          attributes,
+	}
+}
+
+func (m *FirewallPolicyDestinationResourceModel) toFirewallPolicyEndpoint(ctx context.Context, endpoint *firewallPolicyEndpoint) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	setFirewallPolicyEndpoint(endpoint, m.ZoneID, m.MatchingTarget, m.Port, m.MatchOppositeIPs, m.MatchOppositePorts)
+
+	endpoint.AppCategoryIDs, endpoint.AppIDs = nil, nil
+	endpoint.IPs, endpoint.NetworkIDs, endpoint.Regions, endpoint.WebDomains = nil, nil, nil, nil
+	diags.Append(setValueInt64s(ctx, m.AppCategoryIDs, &endpoint.AppCategoryIDs)...)
+	diags.Append(setValueInt64s(ctx, m.AppIDs, &endpoint.AppIDs)...)
+	diags.Append(utils.SetValueStrings(ctx, m.IPs, &endpoint.IPs)...)
+	diags.Append(utils.SetValueStrings(ctx, m.NetworkIDs, &endpoint.NetworkIDs)...)
+	diags.Append(utils.SetValueStrings(ctx, m.Regions, &endpoint.Regions)...)
+	diags.Append(utils.SetValueStrings(ctx, m.WebDomains, &endpoint.WebDomains)...)
+
+	return diags
+}
+
+func newFirewallPolicyDestinationResourceModel(ctx context.Context, endpoint firewallPolicyEndpoint) (*FirewallPolicyDestinationResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := &FirewallPolicyDestinationResourceModel{
+		MatchOppositeIPs:   types.BoolValue(endpoint.MatchOppositeIPs),
+		MatchOppositePorts: types.BoolValue(endpoint.MatchOppositePorts),
+		MatchingTarget:     types.StringValue(endpoint.MatchingTarget),
+		Port:               utils.EmptyStringNull(&endpoint.Port),
+		ZoneID:             types.StringValue(endpoint.ZoneID),
+	}
+
+	var d diag.Diagnostics
+	model.AppCategoryIDs, d = int64SetValue(ctx, endpoint.AppCategoryIDs)
+	diags.Append(d...)
+	model.AppIDs, d = int64SetValue(ctx, endpoint.AppIDs)
+	diags.Append(d...)
+	model.IPs, d = utils.StringSetValue(ctx, endpoint.IPs)
+	diags.Append(d...)
+	model.NetworkIDs, d = utils.StringSetValue(ctx, endpoint.NetworkIDs)
+	diags.Append(d...)
+	model.Regions, d = utils.StringSetValue(ctx, endpoint.Regions)
+	diags.Append(d...)
+	model.WebDomains, d = utils.StringSetValue(ctx, endpoint.WebDomains)
+	diags.Append(d...)
+
+	return model, diags
+}
+
+// firewallPolicyEndpointAttributes returns the attributes shared by the source and destination of a policy, along with
+// the extra matching targets, and the validators for them, the endpoint supports.
+func firewallPolicyEndpointAttributes(matchingTargets []string, matchingTargetValidators ...validator.String) map[string]schema.Attribute {
+	matchingTargets = append([]string{
+		firewallPolicyMatchingTargetAny,
+		firewallPolicyMatchingTargetIP,
+		firewallPolicyMatchingTargetNetwork,
+		firewallPolicyMatchingTargetRegion,
+	}, matchingTargets...)
+
+	validators := append([]validator.String{
+		stringvalidator.OneOf(matchingTargets...),
+		customvalidator.StringValueWithPaths(firewallPolicyMatchingTargetIP, path.MatchRelative().AtParent().AtName("ips")),
+		customvalidator.StringValueWithPaths(firewallPolicyMatchingTargetNetwork, path.MatchRelative().AtParent().AtName("network_ids")),
+		customvalidator.StringValueWithPaths(firewallPolicyMatchingTargetRegion, path.MatchRelative().AtParent().AtName("regions")),
+	}, matchingTargetValidators...)
+
+	return map[string]schema.Attribute{
+		"ips": schema.SetAttribute{
+			MarkdownDescription: "The IP addresses and subnets to match when `matching_target` is `IP`.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(trafficIPAddressValidator{}),
+			},
+		},
+		"match_opposite_ips": schema.BoolAttribute{
+			MarkdownDescription: "When true, traffic not matching `ips` is matched instead.",
+			Computed:            true,
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"match_opposite_ports": schema.BoolAttribute{
+			MarkdownDescription: "When true, traffic not matching `port` is matched instead.",
+			Computed:            true,
+			Optional:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"matching_target": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("What to match within the zone. One of `%s`. Default: `ANY`",
+				strings.Join(matchingTargets, "`, `")),
+			Computed:   true,
+			Optional:   true,
+			Default:    stringdefault.StaticString(firewallPolicyMatchingTargetAny),
+			Validators: validators,
+		},
+		"network_ids": schema.SetAttribute{
+			MarkdownDescription: "The networks to match when `matching_target` is `NETWORK`.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"port": schema.StringAttribute{
+			MarkdownDescription: "A port, port range or comma separated list of either to match, e.g. " +
+				"`80,443,8000-8080`. Only valid for the `tcp`, `udp` and `tcp_udp` protocols.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(portsRegexp, "must be a port, port range or comma separated list of either"),
+			},
+		},
+		"regions": trafficRegionsSchema(),
+		"zone_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the firewall zone.",
+			Required:            true,
+		},
+	}
+}
+
+// setFirewallPolicyEndpoint sets the fields shared by the source and destination of a policy.
+func setFirewallPolicyEndpoint(endpoint *firewallPolicyEndpoint, zoneID, matchingTarget, port types.String, matchOppositeIPs, matchOppositePorts types.Bool) {
+	endpoint.MatchOppositeIPs = matchOppositeIPs.ValueBool()
+	endpoint.MatchOppositePorts = matchOppositePorts.ValueBool()
+	endpoint.MatchingTarget = matchingTarget.ValueString()
+	endpoint.ZoneID = zoneID.ValueString()
+
+	endpoint.MatchingTargetType = ""
+	if endpoint.MatchingTarget == firewallPolicyMatchingTargetIP {
+		endpoint.MatchingTargetType = "SPECIFIC"
+	}
+
+	endpoint.PortMatchingType, endpoint.Port = "ANY", ""
+	if !port.IsNull() {
+		endpoint.PortMatchingType, endpoint.Port = "SPECIFIC", port.ValueString()
+	}
+}
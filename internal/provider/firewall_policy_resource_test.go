@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccFirewallPolicyResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallPolicyConfig("BLOCK", "", `create_allow_respond = true`),
+				ExpectError: regexp.MustCompile(`Return traffic can only be allowed when action is ALLOW`),
+			},
+			{
+				Config:      testAccFirewallPolicyConfig("BLOCK", `port = "443"`, ""),
+				ExpectError: regexp.MustCompile(`Ports can only be matched when protocol is tcp, udp or tcp_udp`),
+			},
+			{
+				Config:      testAccFirewallPolicyConfig("BLOCK", `matching_target = "WEB"`, ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccFirewallPolicyConfig("BLOCK", `matching_target = "CLIENT"`, ""),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccFirewallPolicyResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallPolicyConfig("BLOCK", "", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "name", "Test Policy"),
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "action", "BLOCK"),
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "protocol", "all"),
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "ip_version", "BOTH"),
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "destination.matching_target", "ANY"),
+					resource.TestCheckResourceAttrSet("unifi_firewall_policy.test", "index"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_firewall_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallPolicyConfig("ALLOW", `
+    matching_target = "IP"
+    ips             = ["192.0.2.10"]
+    port            = "443"
+`, `
+  protocol             = "tcp"
+  create_allow_respond = true
+  connection_states    = ["NEW"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "action", "ALLOW"),
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "create_allow_respond", "true"),
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "connection_states.#", "1"),
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "destination.matching_target", "IP"),
+					resource.TestCheckResourceAttr("unifi_firewall_policy.test", "destination.port", "443"),
+				),
+			},
+		},
+	})
+}
+
+// testAccFirewallPolicyConfig creates a policy between two new zones. destination is added to the zone_id of the
+// destination, and settings to the policy.
+func testAccFirewallPolicyConfig(action, destination, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_firewall_zone" "source" {
+  name = "Test Source"
+}
+
+resource "unifi_firewall_zone" "destination" {
+  name = "Test Destination"
+}
+
+resource "unifi_firewall_policy" "test" {
+  name   = "Test Policy"
+  action = %q
+
+  source = {
+    zone_id = unifi_firewall_zone.source.id
+  }
+
+  destination = {
+    zone_id = unifi_firewall_zone.destination.id
+    %s
+  }
+  %s
+}
+`, action, destination, settings)
+}
@@ -0,0 +1,835 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customtype"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	firewallRuleStateEstablished = "established"
+	firewallRuleStateInvalid     = "invalid"
+	firewallRuleStateNew         = "new"
+	firewallRuleStateRelated     = "related"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &FirewallRuleResource{}
+	_ resource.ResourceWithImportState    = &FirewallRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &FirewallRuleResource{}
+	_ resource.ResourceWithValidateConfig = &FirewallRuleResource{}
+
+	defaultFirewallRuleResourceModel         = FirewallRuleResourceModel{}
+	defaultFirewallRuleEndpointResourceModel = FirewallRuleEndpointResourceModel{}
+
+	firewallRuleRulesets = []string{
+		"WAN_IN", "WAN_OUT", "WAN_LOCAL", "LAN_IN", "LAN_OUT", "LAN_LOCAL", "GUEST_IN", "GUEST_OUT", "GUEST_LOCAL",
+		"WANv6_IN", "WANv6_OUT", "WANv6_LOCAL", "LANv6_IN", "LANv6_OUT", "LANv6_LOCAL", "GUESTv6_IN", "GUESTv6_OUT",
+		"GUESTv6_LOCAL",
+	}
+
+	// portsRegexp matches a port, a port range, or a comma separated list of either, e.g. `80,443,8000-8080`.
+	portsRegexp = regexp.MustCompile(`^\d{1,5}(-\d{1,5})?(,\d{1,5}(-\d{1,5})?)*$`)
+)
+
+func NewFirewallRuleResource() resource.Resource {
+	return &FirewallRuleResource{}
+}
+
+// FirewallRuleResource defines the resource implementation.
+type FirewallRuleResource struct {
+	client *unifiClient
+}
+
+func (r *FirewallRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rule"
+}
+
+func (r *FirewallRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultFirewallRuleResourceModel.schema()
+}
+
+func (r *FirewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	// Detect which firewall the default site uses up front. See detectZoneBasedFirewall.
+	detectZoneBasedFirewall(ctx, client)
+}
+
+func (r *FirewallRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FirewallRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+// ModifyPlan checks the site still uses the legacy firewall, and warns when the rule index is already used by another
+// rule in the rule set. This is only a warning as the other rule may be moved to a different index in the same apply,
+// which can't be seen from here.
+func (r *FirewallRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or when the provider hasn't been configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan FirewallRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if plan.Site.ValueString() != "" {
+		site = plan.Site.ValueString()
+	}
+
+	resp.Diagnostics.Append(checkZoneBasedFirewall(ctx, r.client, site, false, "unifi_firewall_rule")...)
+
+	if resp.Diagnostics.HasError() || plan.RuleIndex.IsUnknown() || plan.Ruleset.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state FirewallRuleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Only check when the index could now be in use by a different rule.
+		if state.RuleIndex.Equal(plan.RuleIndex) && state.Ruleset.Equal(plan.Ruleset) {
+			return
+		}
+	}
+
+	rule, err := r.findRuleIndexCollision(ctx, site, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list firewall rules, got error: %s", err))
+		return
+	}
+
+	if rule != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("rule_index"),
+			"Firewall Rule Index Collision",
+			fmt.Sprintf("Rule index %d is already used by firewall rule %q (%s) in the %s rule set. Each rule in a "+
+				"rule set must have a unique index, so applying will fail unless that rule is moved to a different "+
+				"index first.", plan.RuleIndex.ValueInt32(), utils.StringValue(rule.Name), utils.StringValue(rule.ID),
+				plan.Ruleset.ValueString()),
+		)
+	}
+}
+
+func (r *FirewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	rule := &unifi.FirewallRule{}
+	resp.Diagnostics.Append(data.toUnifiFirewallRule(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.createFirewallRule(ctx, site, rule)
+	if err != nil {
+		if diags := r.ruleIndexCollisionError(ctx, site, data); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create firewall rule, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallRuleResourceModel(ctx, rule, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "Firewall rule created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	rule, err := r.client.GetFirewallRule(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rule, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallRuleResourceModel(ctx, rule, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current rule so settings that aren't managed by the resource, e.g. schedules, are left untouched.
+	rule, err := r.client.GetFirewallRule(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall rule, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiFirewallRule(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err = r.client.updateFirewallRule(ctx, site, rule)
+	if err != nil {
+		if diags := r.ruleIndexCollisionError(ctx, site, data); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall rule, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallRuleResourceModel(ctx, rule, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteFirewallRule(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall rule, got error: %s", err))
+		return
+	}
+}
+
+// findRuleIndexCollision returns the other firewall rule using the rule index of the model in its rule set, or nil
+// when there isn't one.
+func (r *FirewallRuleResource) findRuleIndexCollision(ctx context.Context, site string, data FirewallRuleResourceModel) (*unifi.FirewallRule, error) {
+	rules, err := r.client.ListFirewallRule(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.Ruleset == nil || *rule.Ruleset != data.Ruleset.ValueString() ||
+			rule.RuleIndex == nil || int32(*rule.RuleIndex) != data.RuleIndex.ValueInt32() {
+			continue
+		}
+
+		if rule.ID != nil && *rule.ID == data.ID.ValueString() {
+			continue
+		}
+
+		return &rule, nil
+	}
+
+	return nil, nil
+}
+
+// ruleIndexCollisionError explains a failed create or update when the rule index is used by another rule, as the
+// controller doesn't say which rule is in the way. This also covers rules that collide with each other in the same
+// apply, which can't be caught when planning.
+func (r *FirewallRuleResource) ruleIndexCollisionError(ctx context.Context, site string, data FirewallRuleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rule, err := r.findRuleIndexCollision(ctx, site, data)
+	if err != nil || rule == nil {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("rule_index"),
+		"Firewall Rule Index Collision",
+		fmt.Sprintf("Rule index %d is already used by firewall rule %q (%s) in the %s rule set. Each rule in a "+
+			"rule set must have a unique index.", data.RuleIndex.ValueInt32(), utils.StringValue(rule.Name),
+			utils.StringValue(rule.ID), data.Ruleset.ValueString()),
+	)
+
+	return diags
+}
+
+func (r *FirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type FirewallRuleResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	Action       types.String                       `tfsdk:"action"`
+	Destination  *FirewallRuleEndpointResourceModel `tfsdk:"destination"`
+	Enabled      types.Bool                         `tfsdk:"enabled"`
+	ICMPTypeName types.String                       `tfsdk:"icmp_type_name"`
+	IPSec        types.String                       `tfsdk:"ipsec"`
+	Logging      types.Bool                         `tfsdk:"logging"`
+	Name         types.String                       `tfsdk:"name"`
+	Protocol     types.String                       `tfsdk:"protocol"`
+	RuleIndex    types.Int32                        `tfsdk:"rule_index"`
+	Ruleset      types.String                       `tfsdk:"ruleset"`
+	Site         types.String                       `tfsdk:"site"`
+	Source       *FirewallRuleEndpointResourceModel `tfsdk:"source"`
+	States       types.Set                          `tfsdk:"states"`
+}
+
+func (m *FirewallRuleResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi firewall rule. This is for controllers that use the legacy rule set based " +
+			"firewall. Planning a rule for a site that has been migrated to zone-based firewalling fails.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi firewall rule identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"action": schema.StringAttribute{
+				MarkdownDescription: "What to do with matching traffic. One of `accept`, `drop` or `reject`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("accept", "drop", "reject"),
+				},
+			},
+			"destination": defaultFirewallRuleEndpointResourceModel.schema("destination", false),
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"icmp_type_name": schema.StringAttribute{
+				MarkdownDescription: "The ICMP, or ICMPv6 for IPv6 rule sets, type to match, e.g. `echo-request`. " +
+					"Only valid when `protocol` is `icmp` or `icmpv6`.",
+				Optional: true,
+			},
+			"ipsec": schema.StringAttribute{
+				MarkdownDescription: "Match traffic based on whether it was received over IPsec. One of `match-ipsec` " +
+					"or `match-none`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("match-ipsec", "match-none"),
+				},
+			},
+			"logging": schema.BoolAttribute{
+				MarkdownDescription: "When true, traffic matching the rule is logged.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol to match, e.g. `tcp`, `udp`, `tcp_udp`, `icmp` or a protocol " +
+					"number. Default: `all`",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString("all"),
+			},
+			"rule_index": schema.Int32Attribute{
+				MarkdownDescription: "The position of the rule in the rule set. Rules with an index between `2000` " +
+					"and `2999` are evaluated before the predefined rules, those between `4000` and `4999` after " +
+					"them. Each rule in a rule set must have a unique index. Plans warn when the index is used by a rule " +
+					"already on the controller, while rules in the same apply that collide fail when applied.",
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Any(
+						int32validator.Between(2000, 2999),
+						int32validator.Between(4000, 4999),
+					),
+				},
+			},
+			"ruleset": schema.StringAttribute{
+				MarkdownDescription: "The rule set the rule belongs to, e.g. `WAN_IN`, `LAN_IN` or `GUEST_LOCAL`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(firewallRuleRulesets...),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the firewall rule belongs to. Setting this overrides the default site " +
+					"set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": defaultFirewallRuleEndpointResourceModel.schema("source", true),
+			"states": schema.SetAttribute{
+				MarkdownDescription: "Only match traffic in these connection states. Any of `established`, " +
+					"`invalid`, `new` and `related`. When not set traffic in any state is matched.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						firewallRuleStateEstablished,
+						firewallRuleStateInvalid,
+						firewallRuleStateNew,
+						firewallRuleStateRelated,
+					)),
+				},
+			},
+		},
+	}
+}
+
+// isIPv6 returns true when the rule belongs to an IPv6 rule set.
+func (m *FirewallRuleResourceModel) isIPv6() bool {
+	return strings.Contains(m.Ruleset.ValueString(), "v6_")
+}
+
+// validate checks for combinations the controller rejects, so they are reported at plan time with an explanation.
+func (m *FirewallRuleResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	protocol := m.Protocol.ValueString()
+	portsAllowed := m.Protocol.IsUnknown() || slices.Contains([]string{"tcp", "udp", "tcp_udp"}, protocol)
+	for name, endpoint := range map[string]*FirewallRuleEndpointResourceModel{"source": m.Source, "destination": m.Destination} {
+		if endpoint == nil || endpoint.Port.IsNull() || portsAllowed {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root(name).AtName("port"),
+			"Invalid Firewall Rule",
+			fmt.Sprintf("Ports can only be matched when protocol is tcp, udp or tcp_udp, got: %s.", protocol),
+		)
+	}
+
+	if !m.ICMPTypeName.IsNull() && !m.Protocol.IsUnknown() && protocol != "icmp" && protocol != "icmpv6" {
+		diags.AddAttributeError(
+			path.Root("icmp_type_name"),
+			"Invalid Firewall Rule",
+			fmt.Sprintf("An ICMP type can only be matched when protocol is icmp or icmpv6, got: %s.", protocol),
+		)
+	}
+
+	if m.Ruleset.IsUnknown() || !m.isIPv6() {
+		return diags
+	}
+
+	for name, endpoint := range map[string]*FirewallRuleEndpointResourceModel{"source": m.Source, "destination": m.Destination} {
+		if endpoint == nil || endpoint.NetworkType.IsNull() {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root(name).AtName("network_type"),
+			"Invalid Firewall Rule",
+			fmt.Sprintf("The network type can't be set for rules in the IPv6 %s rule set.", m.Ruleset.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+func (m *FirewallRuleResourceModel) toUnifiFirewallRule(ctx context.Context, rule *unifi.FirewallRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var states []string
+	if !m.States.IsNull() {
+		diags.Append(m.States.ElementsAs(ctx, &states, false)...)
+	}
+
+	rule.Action = m.Action.ValueStringPointer()
+	rule.Enabled = m.Enabled.ValueBool()
+	rule.IPSec = m.IPSec.ValueString()
+	rule.Logging = m.Logging.ValueBool()
+	rule.Name = m.Name.ValueStringPointer()
+	rule.RuleIndex = utils.IntPtrValue(m.RuleIndex.ValueInt32Pointer())
+	rule.Ruleset = m.Ruleset.ValueStringPointer()
+	rule.StateEstablished = slices.Contains(states, firewallRuleStateEstablished)
+	rule.StateInvalid = slices.Contains(states, firewallRuleStateInvalid)
+	rule.StateNew = slices.Contains(states, firewallRuleStateNew)
+	rule.StateRelated = slices.Contains(states, firewallRuleStateRelated)
+
+	rule.Protocol, rule.ICMPTypename = m.Protocol.ValueString(), m.ICMPTypeName.ValueString()
+	rule.ProtocolV6, rule.ICMPv6Typename = "", ""
+	if m.isIPv6() {
+		rule.Protocol, rule.ICMPTypename = "", ""
+		rule.ProtocolV6, rule.ICMPv6Typename = m.Protocol.ValueString(), m.ICMPTypeName.ValueString()
+	}
+
+	source := m.Source
+	if source == nil {
+		source = &FirewallRuleEndpointResourceModel{}
+	}
+
+	destination := m.Destination
+	if destination == nil {
+		destination = &FirewallRuleEndpointResourceModel{}
+	}
+
+	srcGroupIDs, d := source.firewallGroupIDs(ctx)
+	diags.Append(d...)
+
+	// The controller expects the group lists to be present, even when they are empty.
+	rule.SrcFirewallGroupIDs = &srcGroupIDs
+	rule.SrcMACAddress = source.MAC.ValueString()
+	rule.SrcNetworkID = source.NetworkID.ValueString()
+	rule.SrcNetworkType = source.NetworkType.ValueStringPointer()
+	rule.SrcPort = source.Port.ValueStringPointer()
+
+	dstGroupIDs, d := destination.firewallGroupIDs(ctx)
+	diags.Append(d...)
+
+	rule.DstFirewallGroupIDs = &dstGroupIDs
+	rule.DstNetworkID = destination.NetworkID.ValueString()
+	rule.DstNetworkType = destination.NetworkType.ValueStringPointer()
+	rule.DstPort = destination.Port.ValueStringPointer()
+
+	rule.SrcAddress, rule.SrcAddressIPV6 = source.Address.ValueStringPointer(), nil
+	rule.DstAddress, rule.DstAddressIPV6 = destination.Address.ValueStringPointer(), nil
+	if m.isIPv6() {
+		rule.SrcAddress, rule.SrcAddressIPV6 = nil, source.Address.ValueStringPointer()
+		rule.DstAddress, rule.DstAddressIPV6 = nil, destination.Address.ValueStringPointer()
+	}
+
+	// Network types are only used by IPv4 rules, and the controller defaults them when a network is set.
+	if !m.isIPv6() {
+		if rule.SrcNetworkType == nil {
+			rule.SrcNetworkType = utils.StringPtr("NETv4")
+		}
+
+		if rule.DstNetworkType == nil {
+			rule.DstNetworkType = utils.StringPtr("NETv4")
+		}
+	}
+
+	return diags
+}
+
+func newFirewallRuleResourceModel(ctx context.Context, rule *unifi.FirewallRule, site string, model FirewallRuleResourceModel) (FirewallRuleResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(rule.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(rule.SiteID)
+
+	// Configurable Values
+	model.Action = types.StringPointerValue(rule.Action)
+	model.Enabled = types.BoolValue(rule.Enabled)
+	model.IPSec = utils.EmptyStringNull(&rule.IPSec)
+	model.Logging = types.BoolValue(rule.Logging)
+	model.Name = types.StringPointerValue(rule.Name)
+	model.RuleIndex = types.Int32PointerValue(utils.Int32PtrValue(rule.RuleIndex))
+	model.Ruleset = types.StringPointerValue(rule.Ruleset)
+
+	ipv6 := model.isIPv6()
+	model.Protocol = types.StringValue(rule.Protocol)
+	model.ICMPTypeName = utils.EmptyStringNull(&rule.ICMPTypename)
+	if ipv6 {
+		model.Protocol = types.StringValue(rule.ProtocolV6)
+		model.ICMPTypeName = utils.EmptyStringNull(&rule.ICMPv6Typename)
+	}
+
+	if model.Protocol.ValueString() == "" {
+		model.Protocol = types.StringValue("all")
+	}
+
+	var states []string
+	for state, set := range map[string]bool{
+		firewallRuleStateEstablished: rule.StateEstablished,
+		firewallRuleStateInvalid:     rule.StateInvalid,
+		firewallRuleStateNew:         rule.StateNew,
+		firewallRuleStateRelated:     rule.StateRelated,
+	} {
+		if set {
+			states = append(states, state)
+		}
+	}
+
+	model.States = types.SetNull(types.StringType)
+	if len(states) > 0 {
+		var d diag.Diagnostics
+		model.States, d = types.SetValueFrom(ctx, types.StringType, states)
+		diags.Append(d...)
+	}
+
+	srcAddress, dstAddress := rule.SrcAddress, rule.DstAddress
+	if ipv6 {
+		srcAddress, dstAddress = rule.SrcAddressIPV6, rule.DstAddressIPV6
+	}
+
+	source, d := newFirewallRuleEndpointResourceModel(ctx, firewallRuleEndpoint{
+		address:          srcAddress,
+		firewallGroupIDs: rule.SrcFirewallGroupIDs,
+		mac:              rule.SrcMACAddress,
+		networkID:        rule.SrcNetworkID,
+		networkType:      rule.SrcNetworkType,
+		port:             rule.SrcPort,
+	}, model.Source, true)
+	diags.Append(d...)
+	model.Source = source
+
+	destination, d := newFirewallRuleEndpointResourceModel(ctx, firewallRuleEndpoint{
+		address:          dstAddress,
+		firewallGroupIDs: rule.DstFirewallGroupIDs,
+		networkID:        rule.DstNetworkID,
+		networkType:      rule.DstNetworkType,
+		port:             rule.DstPort,
+	}, model.Destination, false)
+	diags.Append(d...)
+	model.Destination = destination
+
+	return model, diags
+}
+
+type FirewallRuleEndpointResourceModel struct {
+	Address          types.String   `tfsdk:"address"`
+	FirewallGroupIDs types.Set      `tfsdk:"firewall_group_ids"`
+	MAC              customtype.Mac `tfsdk:"mac"`
+	NetworkID        types.String   `tfsdk:"network_id"`
+	NetworkType      types.String   `tfsdk:"network_type"`
+	Port             types.String   `tfsdk:"port"`
+}
+
+func (m *FirewallRuleEndpointResourceModel) schema(name string, includeMAC bool) schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"address": schema.StringAttribute{
+			MarkdownDescription: "An IP address or CIDR to match. IPv6 addresses are used for IPv6 rule sets.",
+			Optional:            true,
+			Validators: []validator.String{
+				trafficIPAddressValidator{},
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("network_id")),
+			},
+		},
+		"firewall_group_ids": schema.SetAttribute{
+			MarkdownDescription: "The IDs of address and port firewall groups to match.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"network_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of a network to match.",
+			Optional:            true,
+		},
+		"network_type": schema.StringAttribute{
+			MarkdownDescription: "How the network is matched for IPv4 rule sets. `NETv4` matches the whole subnet " +
+				"of the network, `ADDRv4` only the gateway address. Default: `NETv4`",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("ADDRv4", "NETv4"),
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("network_id")),
+			},
+		},
+		"port": schema.StringAttribute{
+			MarkdownDescription: "A port, port range or comma separated list of either to match, e.g. " +
+				"`80,443,8000-8080`. Only valid for the `tcp`, `udp` and `tcp_udp` protocols.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(portsRegexp, "must be a port, port range or comma separated list of either"),
+			},
+		},
+	}
+
+	if includeMAC {
+		attributes["mac"] = schema.StringAttribute{
+			MarkdownDescription: "The MAC address of the client to match.",
+			Optional:            true,
+			CustomType:          customtype.MacType{},
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The %s of the traffic to match. When not set traffic from any %s is "+
+			"matched.", name, name),
+		Optional:   true,
+		Attributes: attributes,
+	}
+}
+
+func (m *FirewallRuleEndpointResourceModel) firewallGroupIDs(ctx context.Context) ([]string, diag.Diagnostics) {
+	groupIDs := make([]string, 0, len(m.FirewallGroupIDs.Elements()))
+	if m.FirewallGroupIDs.IsNull() {
+		return groupIDs, nil
+	}
+
+	diags := m.FirewallGroupIDs.ElementsAs(ctx, &groupIDs, false)
+	return groupIDs, diags
+}
+
+// firewallRuleEndpoint holds the fields of a unifi.FirewallRule for either the source or destination, so they can be
+// converted in the same way.
+type firewallRuleEndpoint struct {
+	address          *string
+	firewallGroupIDs *[]string
+	mac              string
+	networkID        string
+	networkType      *string
+	port             *string
+}
+
+func newFirewallRuleEndpointResourceModel(ctx context.Context, endpoint firewallRuleEndpoint, model *FirewallRuleEndpointResourceModel, includeMAC bool) (*FirewallRuleEndpointResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var groupIDs []string
+	if endpoint.firewallGroupIDs != nil {
+		groupIDs = *endpoint.firewallGroupIDs
+	}
+
+	address, port := utils.EmptyStringNull(endpoint.address), utils.EmptyStringNull(endpoint.port)
+	if address.IsNull() && len(groupIDs) == 0 && endpoint.mac == "" && endpoint.networkID == "" && port.IsNull() {
+		return nil, diags
+	}
+
+	configuredNetworkType := types.StringNull()
+	if model != nil {
+		configuredNetworkType = model.NetworkType
+	}
+
+	model = &FirewallRuleEndpointResourceModel{
+		Address:          address,
+		FirewallGroupIDs: types.SetNull(types.StringType),
+		MAC:              customtype.NewMacNull(),
+		NetworkID:        utils.EmptyStringNull(&endpoint.networkID),
+		NetworkType:      types.StringNull(),
+		Port:             port,
+	}
+
+	if len(groupIDs) > 0 {
+		model.FirewallGroupIDs, diags = types.SetValueFrom(ctx, types.StringType, groupIDs)
+	}
+
+	if includeMAC && endpoint.mac != "" {
+		model.MAC = customtype.NewMacValue(endpoint.mac)
+	}
+
+	// The network type is always returned, so only track it when it's been set or differs from the default.
+	if endpoint.networkID != "" && endpoint.networkType != nil &&
+		(!configuredNetworkType.IsNull() || *endpoint.networkType != "NETv4") {
+		model.NetworkType = types.StringPointerValue(endpoint.networkType)
+	}
+
+	return model, diags
+}
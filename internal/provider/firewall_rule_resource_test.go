@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccFirewallRuleResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallRuleConfig(2000, `rule_index = 3000`),
+				ExpectError: regexp.MustCompile(`Attribute rule_index value must be between`),
+			},
+			{
+				Config: testAccFirewallRuleConfig(2000, `
+  destination = {
+    port = "443"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Ports can only be matched when protocol is tcp, udp or tcp_udp`),
+			},
+			{
+				Config: testAccFirewallRuleConfig(2000, `
+  source = {
+    address    = "192.168.1.10"
+    network_id = "66a5357b30079358c34fe5d9"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccFirewallRuleConfig(2000, `
+  source = {
+    address = "192.168.1.300"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Invalid IP Address`),
+			},
+		},
+	})
+}
+
+func TestAccFirewallRuleResource_IndexCollision(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleConfig(2010, ""),
+			},
+			{
+				Config: testAccFirewallRuleConfig(2010, "") + `
+resource "unifi_firewall_rule" "collision" {
+  name       = "Collision"
+  ruleset    = "LAN_IN"
+  rule_index = 2010
+  action     = "accept"
+}
+`,
+				ExpectError: regexp.MustCompile(`Firewall Rule Index Collision`),
+			},
+			// Rules that collide with each other can only be caught when applying.
+			{
+				Config: testAccFirewallRuleConfig(2010, "") + `
+resource "unifi_firewall_rule" "first" {
+  name       = "First"
+  ruleset    = "LAN_IN"
+  rule_index = 2020
+  action     = "accept"
+}
+
+resource "unifi_firewall_rule" "second" {
+  name       = "Second"
+  ruleset    = "LAN_IN"
+  rule_index = 2020
+  action     = "accept"
+}
+`,
+				ExpectError: regexp.MustCompile(`Firewall Rule Index Collision`),
+			},
+		},
+	})
+}
+
+func TestAccFirewallRuleResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallRuleConfig(2000, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "name", "Test Rule"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "ruleset", "LAN_IN"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "rule_index", "2000"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "protocol", "all"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "logging", "false"),
+					resource.TestCheckNoResourceAttr("unifi_firewall_rule.test", "source"),
+					resource.TestCheckNoResourceAttr("unifi_firewall_rule.test", "destination"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_firewall_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallRuleConfig(2001, `
+  protocol = "tcp"
+  logging  = true
+  states   = ["new", "established"]
+
+  source = {
+    address = "192.168.1.0/24"
+  }
+
+  destination = {
+    address = "10.0.0.10"
+    port    = "80,443"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "rule_index", "2001"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "logging", "true"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "states.#", "2"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "source.address", "192.168.1.0/24"),
+					resource.TestCheckResourceAttr("unifi_firewall_rule.test", "destination.port", "80,443"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallRuleConfig(index int, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_firewall_rule" "test" {
+  name       = "Test Rule"
+  ruleset    = "LAN_IN"
+  rule_index = %d
+  action     = "drop"
+  %s
+}
+`, index, settings)
+}
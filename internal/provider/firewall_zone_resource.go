@@ -0,0 +1,364 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &FirewallZoneResource{}
+	_ resource.ResourceWithImportState = &FirewallZoneResource{}
+	_ resource.ResourceWithModifyPlan  = &FirewallZoneResource{}
+
+	defaultFirewallZoneResourceModel = FirewallZoneResourceModel{}
+)
+
+func NewFirewallZoneResource() resource.Resource {
+	return &FirewallZoneResource{}
+}
+
+// FirewallZoneResource defines the resource implementation.
+type FirewallZoneResource struct {
+	client *unifiClient
+}
+
+func (r *FirewallZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_zone"
+}
+
+func (r *FirewallZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultFirewallZoneResourceModel.schema()
+}
+
+func (r *FirewallZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	// Detect which firewall the default site uses up front. See detectZoneBasedFirewall.
+	detectZoneBasedFirewall(ctx, client)
+}
+
+// ModifyPlan checks the site uses zone-based firewalling, which zones are part of.
+func (r *FirewallZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or when the provider hasn't been configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan FirewallZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if plan.Site.ValueString() != "" {
+		site = plan.Site.ValueString()
+	}
+
+	resp.Diagnostics.Append(checkZoneBasedFirewall(ctx, r.client, site, true, "unifi_firewall_zone")...)
+}
+
+func (r *FirewallZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FirewallZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	zone := &firewallZone{}
+	resp.Diagnostics.Append(data.toFirewallZone(ctx, zone)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.createFirewallZone(ctx, site, zone)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create firewall zone, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallZoneResourceModel(ctx, zone, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Firewall zone created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FirewallZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	zone, err := r.client.getFirewallZone(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall zone, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallZoneResourceModel(ctx, zone, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FirewallZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current zone so settings that aren't managed by the resource are left untouched.
+	zone, err := r.client.getFirewallZone(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read firewall zone, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toFirewallZone(ctx, zone)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err = r.client.updateFirewallZone(ctx, site, zone)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update firewall zone, got error: %s", err))
+		return
+	}
+
+	data, diags := newFirewallZoneResourceModel(ctx, zone, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FirewallZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.deleteFirewallZone(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete firewall zone, got error: %s", err))
+		return
+	}
+}
+
+func (r *FirewallZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type FirewallZoneResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	Name       types.String `tfsdk:"name"`
+	NetworkIDs types.Set    `tfsdk:"network_ids"`
+	Site       types.String `tfsdk:"site"`
+}
+
+func (m *FirewallZoneResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A custom firewall zone. Firewall policies apply to the traffic between zones. This is " +
+			"for sites that use zone-based firewalling, available from Network 9. The firewall type of the " +
+			"provider's site is detected when the provider is configured, and of any other site when planning, so " +
+			"zones for a legacy site fail before anything is applied.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi firewall zone identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"network_ids": schema.SetAttribute{
+				MarkdownDescription: "The networks in the zone. A network can only be in one zone, and is moved out " +
+					"of its current zone when added.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the firewall zone belongs to. Setting this overrides the default site " +
+					"set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (m *FirewallZoneResourceModel) toFirewallZone(ctx context.Context, zone *firewallZone) diag.Diagnostics {
+	zone.Name = m.Name.ValueString()
+	zone.NetworkIDs = make([]string, 0)
+
+	return utils.SetValueStrings(ctx, m.NetworkIDs, &zone.NetworkIDs)
+}
+
+func newFirewallZoneResourceModel(ctx context.Context, zone *firewallZone, site string, model FirewallZoneResourceModel) (FirewallZoneResourceModel, diag.Diagnostics) {
+	// Computed values
+	model.ID = types.StringPointerValue(zone.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.Name = types.StringValue(zone.Name)
+
+	var diags diag.Diagnostics
+	model.NetworkIDs, diags = utils.StringSetValue(ctx, zone.NetworkIDs)
+
+	return model, diags
+}
+
+// detectZoneBasedFirewall works out the firewall type of the provider's default site when a firewall resource is
+// configured, so it's cached before planning. Errors are only logged so refreshing and destroying still work, and the
+// detection is retried by checkZoneBasedFirewall.
+func detectZoneBasedFirewall(ctx context.Context, client *unifiClient) {
+	if _, err := client.zoneBasedFirewall(ctx, client.site); err != nil {
+		tflog.Warn(ctx, "Unable to detect the firewall type of the site", map[string]interface{}{
+			"site":  client.site,
+			"error": err.Error(),
+		})
+	}
+}
+
+// checkZoneBasedFirewall returns an error when the site doesn't use the type of firewall a resource manages. The
+// controller rejects the other type with a generic error, or for legacy rules, silently ignores them once the site has
+// been migrated.
+func checkZoneBasedFirewall(ctx context.Context, client *unifiClient, site string, zoneBased bool, resourceType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	siteZoneBased, err := client.zoneBasedFirewall(ctx, site)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to detect the firewall type of the site, got error: %s", err))
+		return diags
+	}
+
+	switch {
+	case zoneBased && !siteZoneBased:
+		diags.AddError(
+			"Zone-Based Firewall Not Enabled",
+			fmt.Sprintf("The site %q uses the legacy rule set based firewall, which %s can't be used with. Use "+
+				"unifi_firewall_rule instead, or migrate the site to zone-based firewalling in the controller.",
+				site, resourceType),
+		)
+	case !zoneBased && siteZoneBased:
+		diags.AddError(
+			"Zone-Based Firewall Enabled",
+			fmt.Sprintf("The site %q uses zone-based firewalling, which %s can't be used with. Use "+
+				"unifi_firewall_zone and unifi_firewall_policy instead.", site, resourceType),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccFirewallZoneResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFirewallZoneConfig("Test Zone"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_zone.test", "name", "Test Zone"),
+					resource.TestCheckNoResourceAttr("unifi_firewall_zone.test", "network_ids"),
+					resource.TestCheckResourceAttrSet("unifi_firewall_zone.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_firewall_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFirewallZoneConfig("Test Zone Renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_firewall_zone.test", "name", "Test Zone Renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallZoneConfig(name string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_firewall_zone" "test" {
+  name = %q
+}
+`, name)
+}
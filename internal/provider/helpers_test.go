@@ -13,6 +13,7 @@ import (
 
 var (
 	devicesReady   = sync.Once{}
+	accessPoints   []unifi.Device
 	switchPool     []*unifi.Device
 	switchPoolLock sync.Mutex
 )
@@ -35,6 +36,10 @@ func cacheDeviceDetails(ctx context.Context, t *testing.T) {
 			defer switchPoolLock.Unlock()
 			for _, device := range devices {
 				switch *device.Type {
+				case "uap":
+					if device.Adopted {
+						accessPoints = append(accessPoints, device)
+					}
 				case "usw":
 					model := *device.Model
 					if !strings.HasPrefix(model, "US24") && !strings.HasPrefix(model, "US48") {
@@ -81,6 +86,24 @@ func getSwitchDevice(ctx context.Context, t *testing.T) (*unifi.Device, func())
 	return device, release
 }
 
+// getAccessPointDevice returns an adopted access point. Access points aren't changed by being used in tests, so unlike
+// switches they can be shared.
+func getAccessPointDevice(ctx context.Context, t *testing.T) *unifi.Device {
+	t.Helper()
+
+	// Devices take a little bit of time to load so retry until we have devices
+	cacheDeviceDetails(ctx, t)
+
+	switchPoolLock.Lock()
+	defer switchPoolLock.Unlock()
+
+	if len(accessPoints) == 0 {
+		t.Fatal("no adopted access points found")
+	}
+
+	return &accessPoints[0]
+}
+
 func getNetwork(ctx context.Context, t *testing.T) *unifi.Network {
 	t.Helper()
 
@@ -0,0 +1,708 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"net/netip"
+)
+
+const (
+	networkPurposeCorporate = "corporate"
+	networkPurposeVLANOnly  = "vlan-only"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &NetworkResource{}
+	_ resource.ResourceWithImportState    = &NetworkResource{}
+	_ resource.ResourceWithValidateConfig = &NetworkResource{}
+
+	defaultNetworkDHCPServerResourceModel = NetworkDHCPServerResourceModel{}
+	defaultNetworkResourceModel           = NetworkResourceModel{}
+)
+
+func NewNetworkResource() resource.Resource {
+	return &NetworkResource{}
+}
+
+// NetworkResource defines the resource implementation.
+type NetworkResource struct {
+	client *unifiClient
+}
+
+func (r *NetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultNetworkResourceModel.schema()
+}
+
+func (r *NetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NetworkResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network := &unifi.Network{
+		Enabled:               true,
+		InternetAccessEnabled: true,
+		IPV6InterfaceType:     utils.StringPtr("none"),
+		IsNAT:                 true,
+		NetworkGroup:          utils.StringPtr("LAN"),
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := r.client.CreateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create network, got error: %s", err))
+		return
+	}
+
+	data, diags := newNetworkResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "Network created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network, got error: %s", err))
+		return
+	}
+
+	data, diags := newNetworkResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current network so settings that aren't managed by the resource are left untouched.
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err = r.client.UpdateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update network, got error: %s", err))
+		return
+	}
+
+	data, diags := newNetworkResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteNetwork(ctx, site, data.ID.ValueString(), data.Name.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type NetworkResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	DHCPRelay        types.Bool                      `tfsdk:"dhcp_relay"`
+	DHCPServer       *NetworkDHCPServerResourceModel `tfsdk:"dhcp_server"`
+	DomainName       types.String                    `tfsdk:"domain_name"`
+	IGMPSnooping     types.Bool                      `tfsdk:"igmp_snooping"`
+	Name             types.String                    `tfsdk:"name"`
+	NetworkIsolation types.Bool                      `tfsdk:"network_isolation"`
+	Purpose          types.String                    `tfsdk:"purpose"`
+	Site             types.String                    `tfsdk:"site"`
+	Subnet           cidrtypes.IPv4Prefix            `tfsdk:"subnet"`
+	VLANID           types.Int32                     `tfsdk:"vlan_id"`
+}
+
+func (m *NetworkResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi LAN network. This supports both gateway managed (`corporate`) networks and " +
+			"`vlan-only` networks which are routed elsewhere.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi network identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"dhcp_relay": schema.BoolAttribute{
+				MarkdownDescription: "When true, DHCP requests on the network are relayed to the DHCP servers set in " +
+					"the gateway settings.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"dhcp_server": defaultNetworkDHCPServerResourceModel.schema(),
+			"domain_name": schema.StringAttribute{
+				MarkdownDescription: "The domain name handed out to clients on the network.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(253),
+				},
+			},
+			"igmp_snooping": schema.BoolAttribute{
+				MarkdownDescription: "When true, switches only forward multicast traffic to ports that have joined the " +
+					"multicast group.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"network_isolation": schema.BoolAttribute{
+				MarkdownDescription: "When true, clients on the network cannot reach other networks.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"purpose": schema.StringAttribute{
+				MarkdownDescription: "The purpose of the network. One of `corporate` or `vlan-only`. Default: `corporate`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(networkPurposeCorporate),
+				Validators: []validator.String{
+					stringvalidator.OneOf(networkPurposeCorporate, networkPurposeVLANOnly),
+					customvalidator.StringValueWithPaths(networkPurposeVLANOnly, path.MatchRoot("vlan_id")),
+					customvalidator.StringValueConflictsWithPaths(networkPurposeVLANOnly,
+						path.MatchRoot("dhcp_server"),
+						path.MatchRoot("subnet"),
+					),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the network belongs to. Setting this overrides the default site set in " +
+					"the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet": schema.StringAttribute{
+				MarkdownDescription: "The gateway IP address and prefix length of the network, e.g. `192.168.1.1/24`.",
+				Optional:            true,
+				CustomType:          cidrtypes.IPv4PrefixType{},
+			},
+			"vlan_id": schema.Int32Attribute{
+				MarkdownDescription: "The VLAN ID of the network. When not set on a `corporate` network, the network " +
+					"is untagged.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(2, 4009),
+				},
+			},
+		},
+	}
+}
+
+// validate checks the addressing of the network is consistent. This can't be done with attribute validators as it
+// depends on multiple values.
+func (m *NetworkResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// purpose defaults to corporate, which the schema validators can't see when it isn't set.
+	corporate := m.Purpose.IsNull() || m.Purpose.ValueString() == networkPurposeCorporate
+	if corporate && !m.Purpose.IsUnknown() && m.Subnet.IsNull() {
+		diags.AddAttributeError(
+			path.Root("subnet"),
+			"Invalid Attribute Combination",
+			"subnet must be set for corporate networks.",
+		)
+	}
+
+	if m.Subnet.IsNull() || m.Subnet.IsUnknown() {
+		return diags
+	}
+
+	subnet, d := m.Subnet.ValueIPv4Prefix()
+	if d.HasError() {
+		// The custom type will already have reported this
+		return diags
+	}
+
+	network := subnet.Masked()
+	gateway := subnet.Addr()
+	if gateway == network.Addr() || gateway == utils.LastAddr(network) {
+		diags.AddAttributeError(
+			path.Root("subnet"),
+			"Invalid Subnet",
+			fmt.Sprintf("The subnet must be the gateway IP address and prefix length, e.g. %s/%d, got %s.",
+				network.Addr().Next(), network.Bits(), subnet),
+		)
+
+		return diags
+	}
+
+	if m.DHCPServer == nil {
+		return diags
+	}
+
+	start, startOK := utils.AddrValue(m.DHCPServer.Start)
+	stop, stopOK := utils.AddrValue(m.DHCPServer.Stop)
+	for name, addr := range map[string]netip.Addr{"start": start, "stop": stop} {
+		if !addr.IsValid() {
+			continue
+		}
+
+		if !network.Contains(addr) || addr == network.Addr() || addr == utils.LastAddr(network) {
+			diags.AddAttributeError(
+				path.Root("dhcp_server").AtName(name),
+				"Invalid DHCP Range",
+				fmt.Sprintf("The DHCP range must be within the usable addresses of %s, got %s.", network, addr),
+			)
+		}
+	}
+
+	if startOK && stopOK && stop.Less(start) {
+		diags.AddAttributeError(
+			path.Root("dhcp_server").AtName("stop"),
+			"Invalid DHCP Range",
+			fmt.Sprintf("The end of the DHCP range (%s) must not be before the start (%s).", stop, start),
+		)
+	}
+
+	return diags
+}
+
+func (m *NetworkResourceModel) toUnifiNetwork(ctx context.Context, network *unifi.Network) diag.Diagnostics {
+	network.DHCPRelayEnabled = m.DHCPRelay.ValueBool()
+	network.DomainName = m.DomainName.ValueString()
+	network.IGMPSnooping = m.IGMPSnooping.ValueBool()
+	network.Name = m.Name.ValueStringPointer()
+	network.NetworkIsolationEnabled = m.NetworkIsolation.ValueBool()
+	network.Purpose = m.Purpose.ValueStringPointer()
+	network.VLAN = utils.IntPtrValue(m.VLANID.ValueInt32Pointer())
+	network.VLANEnabled = !m.VLANID.IsNull()
+
+	network.IPSubnet = nil
+	if !m.Subnet.IsNull() {
+		network.IPSubnet = m.Subnet.ValueStringPointer()
+	}
+
+	return m.DHCPServer.toUnifiNetwork(ctx, network)
+}
+
+func newNetworkResourceModel(ctx context.Context, network *unifi.Network, site string, model NetworkResourceModel) (NetworkResourceModel, diag.Diagnostics) {
+	// Computed values
+	model.ID = types.StringPointerValue(network.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(network.SiteID)
+
+	// Configurable Values
+	model.DHCPRelay = types.BoolValue(network.DHCPRelayEnabled)
+	model.DomainName = types.StringValue(network.DomainName)
+	model.IGMPSnooping = types.BoolValue(network.IGMPSnooping)
+	model.Name = types.StringPointerValue(network.Name)
+	model.NetworkIsolation = types.BoolValue(network.NetworkIsolationEnabled)
+	model.Purpose = types.StringPointerValue(network.Purpose)
+
+	model.Subnet = cidrtypes.NewIPv4PrefixNull()
+	if network.IPSubnet != nil && *network.IPSubnet != "" {
+		model.Subnet = cidrtypes.NewIPv4PrefixValue(*network.IPSubnet)
+	}
+
+	model.VLANID = types.Int32Null()
+	if network.VLANEnabled {
+		model.VLANID = types.Int32PointerValue(utils.Int32PtrValue(network.VLAN))
+	}
+
+	dhcpServer, diags := newNetworkDHCPServerResourceModel(ctx, network, model.DHCPServer)
+	model.DHCPServer = dhcpServer
+
+	return model, diags
+}
+
+type NetworkDHCPServerResourceModel struct {
+	BootFilename     types.String        `tfsdk:"boot_filename"`
+	BootServer       iptypes.IPv4Address `tfsdk:"boot_server"`
+	ConflictChecking types.Bool          `tfsdk:"conflict_checking"`
+	DNSServers       types.List          `tfsdk:"dns_servers"`
+	Gateway          iptypes.IPv4Address `tfsdk:"gateway"`
+	LeaseTime        types.Int32         `tfsdk:"lease_time"`
+	NTPServers       types.List          `tfsdk:"ntp_servers"`
+	Start            iptypes.IPv4Address `tfsdk:"start"`
+	Stop             iptypes.IPv4Address `tfsdk:"stop"`
+	TFTPServer       types.String        `tfsdk:"tftp_server"`
+	TimeOffset       types.Int32         `tfsdk:"time_offset"`
+	UnifiController  iptypes.IPv4Address `tfsdk:"unifi_controller"`
+	WINSServers      types.List          `tfsdk:"wins_servers"`
+	WPADURL          types.String        `tfsdk:"wpad_url"`
+}
+
+func (m *NetworkDHCPServerResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Run a DHCP server on the network. When not set the gateway will not hand out " +
+			"addresses on the network.",
+		Optional: true,
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRoot("dhcp_relay")),
+		},
+		Attributes: map[string]schema.Attribute{
+			"boot_filename": schema.StringAttribute{
+				MarkdownDescription: "The file clients should network boot from.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("boot_server")),
+				},
+			},
+			"boot_server": schema.StringAttribute{
+				MarkdownDescription: "The server clients should network boot from.",
+				Optional:            true,
+				CustomType:          iptypes.IPv4AddressType{},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("boot_filename")),
+				},
+			},
+			"conflict_checking": schema.BoolAttribute{
+				MarkdownDescription: "When true, the DHCP server checks an address is unused before handing it out.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"dns_servers": schema.ListAttribute{
+				MarkdownDescription: "The DNS servers handed out to clients. When not set the gateway is used.",
+				ElementType:         iptypes.IPv4AddressType{},
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 4),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "The default gateway handed out to clients. When not set the gateway IP of the " +
+					"subnet is used.",
+				Optional:   true,
+				CustomType: iptypes.IPv4AddressType{},
+			},
+			"lease_time": schema.Int32Attribute{
+				MarkdownDescription: "The DHCP lease time in seconds. Default: `86400`",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(86400),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(60),
+				},
+			},
+			"ntp_servers": schema.ListAttribute{
+				ElementType: iptypes.IPv4AddressType{},
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 2),
+				},
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "The first address of the DHCP range.",
+				Required:            true,
+				CustomType:          iptypes.IPv4AddressType{},
+			},
+			"stop": schema.StringAttribute{
+				MarkdownDescription: "The last address of the DHCP range.",
+				Required:            true,
+				CustomType:          iptypes.IPv4AddressType{},
+			},
+			"tftp_server": schema.StringAttribute{
+				MarkdownDescription: "The TFTP server handed out to clients (option 66).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"time_offset": schema.Int32Attribute{
+				MarkdownDescription: "The time offset from UTC in seconds.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(-86400, 86400),
+				},
+			},
+			"unifi_controller": schema.StringAttribute{
+				MarkdownDescription: "The address of the Unifi controller handed out to devices (option 43).",
+				Optional:            true,
+				CustomType:          iptypes.IPv4AddressType{},
+			},
+			"wins_servers": schema.ListAttribute{
+				ElementType: iptypes.IPv4AddressType{},
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 2),
+				},
+			},
+			"wpad_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the web proxy auto-discovery file handed out to clients (option 252).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (m *NetworkDHCPServerResourceModel) toUnifiNetwork(ctx context.Context, network *unifi.Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m == nil {
+		network.DHCPDEnabled = false
+		return diags
+	}
+
+	network.DHCPDEnabled = true
+	network.DHCPDConflictChecking = m.ConflictChecking.ValueBool()
+	network.DHCPDLeaseTime = utils.IntPtrValue(m.LeaseTime.ValueInt32Pointer())
+	network.DHCPDStart = m.Start.ValueString()
+	network.DHCPDStop = m.Stop.ValueString()
+
+	network.DHCPDBootEnabled = !m.BootServer.IsNull()
+	network.DHCPDBootFilename = m.BootFilename.ValueStringPointer()
+	network.DHCPDBootServer = m.BootServer.ValueString()
+
+	network.DHCPDGatewayEnabled = !m.Gateway.IsNull()
+	network.DHCPDGateway = m.Gateway.ValueString()
+
+	network.DHCPDTFTPServer = utils.StringPtr(m.TFTPServer.ValueString())
+	network.DHCPDUnifiController = m.UnifiController.ValueString()
+	network.DHCPDWPAdUrl = utils.StringPtr(m.WPADURL.ValueString())
+
+	network.DHCPDTimeOffsetEnabled = !m.TimeOffset.IsNull()
+	network.DHCPDTimeOffset = utils.IntPtrValue(m.TimeOffset.ValueInt32Pointer())
+
+	var dnsServers []string
+	diags.Append(utils.ListValueStrings(ctx, m.DNSServers, &dnsServers)...)
+	network.DHCPDDNSEnabled = len(dnsServers) > 0
+	network.DHCPDDNS1, network.DHCPDDNS2, network.DHCPDDNS3, network.DHCPDDNS4 = utils.IndexString(dnsServers, 0),
+		utils.IndexString(dnsServers, 1), utils.IndexString(dnsServers, 2), utils.IndexString(dnsServers, 3)
+
+	var ntpServers []string
+	diags.Append(utils.ListValueStrings(ctx, m.NTPServers, &ntpServers)...)
+	network.DHCPDNtpEnabled = len(ntpServers) > 0
+	network.DHCPDNtp1, network.DHCPDNtp2 = utils.IndexString(ntpServers, 0), utils.IndexString(ntpServers, 1)
+
+	var winsServers []string
+	diags.Append(utils.ListValueStrings(ctx, m.WINSServers, &winsServers)...)
+	network.DHCPDWinsEnabled = len(winsServers) > 0
+	network.DHCPDWins1, network.DHCPDWins2 = utils.IndexString(winsServers, 0), utils.IndexString(winsServers, 1)
+
+	return diags
+}
+
+func newNetworkDHCPServerResourceModel(ctx context.Context, network *unifi.Network, model *NetworkDHCPServerResourceModel) (*NetworkDHCPServerResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !network.DHCPDEnabled {
+		return nil, diags
+	}
+
+	if model == nil {
+		model = &NetworkDHCPServerResourceModel{}
+	}
+
+	model.ConflictChecking = types.BoolValue(network.DHCPDConflictChecking)
+	model.LeaseTime = types.Int32PointerValue(utils.Int32PtrValue(network.DHCPDLeaseTime))
+	model.Start = iptypes.NewIPv4AddressValue(network.DHCPDStart)
+	model.Stop = iptypes.NewIPv4AddressValue(network.DHCPDStop)
+
+	model.BootFilename = types.StringNull()
+	model.BootServer = iptypes.NewIPv4AddressNull()
+	if network.DHCPDBootEnabled {
+		model.BootFilename = types.StringPointerValue(network.DHCPDBootFilename)
+		model.BootServer = iptypes.NewIPv4AddressValue(network.DHCPDBootServer)
+	}
+
+	model.Gateway = iptypes.NewIPv4AddressNull()
+	if network.DHCPDGatewayEnabled {
+		model.Gateway = iptypes.NewIPv4AddressValue(network.DHCPDGateway)
+	}
+
+	model.TFTPServer = utils.EmptyStringNull(network.DHCPDTFTPServer)
+	model.WPADURL = utils.EmptyStringNull(network.DHCPDWPAdUrl)
+
+	model.UnifiController = iptypes.NewIPv4AddressNull()
+	if network.DHCPDUnifiController != "" {
+		model.UnifiController = iptypes.NewIPv4AddressValue(network.DHCPDUnifiController)
+	}
+
+	model.TimeOffset = types.Int32Null()
+	if network.DHCPDTimeOffsetEnabled {
+		model.TimeOffset = types.Int32PointerValue(utils.Int32PtrValue(network.DHCPDTimeOffset))
+	}
+
+	var d diag.Diagnostics
+	model.DNSServers = types.ListNull(iptypes.IPv4AddressType{})
+	if network.DHCPDDNSEnabled {
+		model.DNSServers, d = utils.AddressListValue(ctx, network.DHCPDDNS1, network.DHCPDDNS2, network.DHCPDDNS3, network.DHCPDDNS4)
+		diags.Append(d...)
+	}
+
+	model.NTPServers = types.ListNull(iptypes.IPv4AddressType{})
+	if network.DHCPDNtpEnabled {
+		model.NTPServers, d = utils.AddressListValue(ctx, network.DHCPDNtp1, network.DHCPDNtp2)
+		diags.Append(d...)
+	}
+
+	model.WINSServers = types.ListNull(iptypes.IPv4AddressType{})
+	if network.DHCPDWinsEnabled {
+		model.WINSServers, d = utils.AddressListValue(ctx, network.DHCPDWins1, network.DHCPDWins2)
+		diags.Append(d...)
+	}
+
+	return model, diags
+}
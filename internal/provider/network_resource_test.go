@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccNetworkResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNetworkConfigDHCP("10.0.50.1/24", "10.0.50.100", "10.0.51.10"),
+				ExpectError: regexp.MustCompile(`The DHCP range must be within the usable addresses of 10.0.50.0/24`),
+			},
+			{
+				Config:      testAccNetworkConfigDHCP("10.0.50.1/24", "10.0.50.200", "10.0.50.100"),
+				ExpectError: regexp.MustCompile(`must not be before the start`),
+			},
+			{
+				Config:      testAccNetworkConfigDHCP("10.0.50.0/24", "10.0.50.100", "10.0.50.200"),
+				ExpectError: regexp.MustCompile(`The subnet must be the gateway IP address and prefix length`),
+			},
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network" "test" {
+  name    = "Test VLAN"
+  purpose = "vlan-only"
+  subnet  = "10.0.50.1/24"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network" "test" {
+  name = "Test Network"
+}
+`,
+				ExpectError: regexp.MustCompile(`subnet must be set for corporate networks`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkResource_Corporate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkConfigDHCP("10.0.50.1/24", "10.0.50.100", "10.0.50.200"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network.test", "name", "Test Network"),
+					resource.TestCheckResourceAttr("unifi_network.test", "purpose", "corporate"),
+					resource.TestCheckResourceAttr("unifi_network.test", "subnet", "10.0.50.1/24"),
+					resource.TestCheckResourceAttr("unifi_network.test", "vlan_id", "50"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_server.start", "10.0.50.100"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_server.stop", "10.0.50.200"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_server.lease_time", "86400"),
+					resource.TestCheckNoResourceAttr("unifi_network.test", "dhcp_server.dns_servers"),
+					resource.TestCheckResourceAttrWith("unifi_network.test", "id", func(value string) error {
+						if value == "" {
+							return errors.New("id is required")
+						}
+
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network" "test" {
+  name              = "Test Network"
+  subnet            = "10.0.50.1/24"
+  vlan_id           = 50
+  domain_name       = "test.internal"
+  igmp_snooping     = true
+  network_isolation = true
+
+  dhcp_server = {
+    start       = "10.0.50.10"
+    stop        = "10.0.50.20"
+    lease_time  = 3600
+    dns_servers = ["1.1.1.1", "1.0.0.1"]
+    ntp_servers = ["10.0.50.1"]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network.test", "domain_name", "test.internal"),
+					resource.TestCheckResourceAttr("unifi_network.test", "igmp_snooping", "true"),
+					resource.TestCheckResourceAttr("unifi_network.test", "network_isolation", "true"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_server.lease_time", "3600"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_server.dns_servers.#", "2"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_server.dns_servers.1", "1.0.0.1"),
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_server.ntp_servers.0", "10.0.50.1"),
+				),
+			},
+			// Switch to relaying DHCP requests
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network" "test" {
+  name       = "Test Network"
+  subnet     = "10.0.50.1/24"
+  vlan_id    = 50
+  dhcp_relay = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network.test", "dhcp_relay", "true"),
+					resource.TestCheckNoResourceAttr("unifi_network.test", "dhcp_server"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkConfigDHCP(subnet, start, stop string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_network" "test" {
+  name    = "Test Network"
+  subnet  = %[1]q
+  vlan_id = 50
+
+  dhcp_server = {
+    start = %[2]q
+    stop  = %[3]q
+  }
+}
+`, subnet, start, stop)
+}
+
+func TestAccNetworkResource_VLANOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network" "test" {
+  name    = "Test VLAN"
+  purpose = "vlan-only"
+  vlan_id = 60
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network.test", "purpose", "vlan-only"),
+					resource.TestCheckResourceAttr("unifi_network.test", "vlan_id", "60"),
+					resource.TestCheckNoResourceAttr("unifi_network.test", "subnet"),
+					resource.TestCheckNoResourceAttr("unifi_network.test", "dhcp_server"),
+				),
+			},
+		},
+	})
+}
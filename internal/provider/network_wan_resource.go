@@ -0,0 +1,636 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+)
+
+const (
+	networkPurposeWAN = "wan"
+
+	wanDNSPreferenceAuto   = "auto"
+	wanDNSPreferenceManual = "manual"
+
+	wanLoadBalanceTypeFailoverOnly = "failover-only"
+	wanLoadBalanceTypeWeighted     = "weighted"
+
+	wanTypePPPoE = "pppoe"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &NetworkWANResource{}
+	_ resource.ResourceWithImportState    = &NetworkWANResource{}
+	_ resource.ResourceWithValidateConfig = &NetworkWANResource{}
+
+	defaultNetworkWANPPPoEResourceModel            = NetworkWANPPPoEResourceModel{}
+	defaultNetworkWANResourceModel                 = NetworkWANResourceModel{}
+	defaultNetworkWANSmartQueueResourceModel       = NetworkWANSmartQueueResourceModel{}
+	defaultNetworkWANStaticIPSettingsResourceModel = NetworkWANStaticIPSettingsResourceModel{}
+)
+
+func NewNetworkWANResource() resource.Resource {
+	return &NetworkWANResource{}
+}
+
+// NetworkWANResource defines the resource implementation.
+type NetworkWANResource struct {
+	client *unifiClient
+}
+
+func (r *NetworkWANResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_wan"
+}
+
+func (r *NetworkWANResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultNetworkWANResourceModel.schema()
+}
+
+func (r *NetworkWANResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkWANResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NetworkWANResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+func (r *NetworkWANResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkWANResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network := &unifi.Network{
+		Enabled:         true,
+		Purpose:         utils.StringPtr(networkPurposeWAN),
+		WANTypeV6:       utils.StringPtr("disabled"),
+		WANNetworkGroup: data.NetworkGroup.ValueStringPointer(),
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := r.client.CreateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create WAN network, got error: %s", err))
+		return
+	}
+
+	data, diags := newNetworkWANResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "WAN network created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkWANResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkWANResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WAN network, got error: %s", err))
+		return
+	}
+
+	if network.Purpose == nil || *network.Purpose != networkPurposeWAN {
+		resp.Diagnostics.AddError(
+			"Invalid Network Purpose",
+			fmt.Sprintf("Network %s is not a WAN network. Use the unifi_network resource to manage it.", data.ID.ValueString()),
+		)
+
+		return
+	}
+
+	data, diags := newNetworkWANResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkWANResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkWANResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current network so settings that aren't managed by the resource are left untouched.
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WAN network, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err = r.client.UpdateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update WAN network, got error: %s", err))
+		return
+	}
+
+	data, diags := newNetworkWANResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkWANResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkWANResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RemoveOnDestroy.ValueBool() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteNetwork(ctx, site, data.ID.ValueString(), data.Name.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete WAN network, got error: %s", err))
+		return
+	}
+}
+
+func (r *NetworkWANResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type NetworkWANResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	DNSServers        types.List                               `tfsdk:"dns_servers"`
+	LoadBalanceType   types.String                             `tfsdk:"load_balance_type"`
+	LoadBalanceWeight types.Int32                              `tfsdk:"load_balance_weight"`
+	Name              types.String                             `tfsdk:"name"`
+	NetworkGroup      types.String                             `tfsdk:"network_group"`
+	PPPoE             *NetworkWANPPPoEResourceModel            `tfsdk:"pppoe"`
+	RemoveOnDestroy   types.Bool                               `tfsdk:"remove_on_destroy"`
+	Site              types.String                             `tfsdk:"site"`
+	SmartQueue        *NetworkWANSmartQueueResourceModel       `tfsdk:"smart_queue"`
+	StaticIPSettings  *NetworkWANStaticIPSettingsResourceModel `tfsdk:"static_ip_settings"`
+	Type              types.String                             `tfsdk:"type"`
+	VLANID            types.Int32                              `tfsdk:"vlan_id"`
+}
+
+func (m *NetworkWANResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi WAN network. Gateways come with their WAN networks already created so these " +
+			"will usually need to be imported. Destroying the resource only removes it from state unless " +
+			"`remove_on_destroy` is set.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi network identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"dns_servers": schema.ListAttribute{
+				MarkdownDescription: "Override the DNS servers provided by the ISP.",
+				ElementType:         iptypes.IPv4AddressType{},
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 2),
+				},
+			},
+			"load_balance_type": schema.StringAttribute{
+				MarkdownDescription: "How the WAN is used alongside other WANs. With `failover-only` the WAN is only " +
+					"used when the others are down, with `weighted` traffic is load balanced across the WANs. " +
+					"Default: `failover-only`",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(wanLoadBalanceTypeFailoverOnly),
+				Validators: []validator.String{
+					stringvalidator.OneOf(wanLoadBalanceTypeFailoverOnly, wanLoadBalanceTypeWeighted),
+					customvalidator.StringValueWithPaths(wanLoadBalanceTypeWeighted, path.MatchRoot("load_balance_weight")),
+				},
+			},
+			"load_balance_weight": schema.Int32Attribute{
+				MarkdownDescription: "The share of traffic sent over the WAN when `load_balance_type` is `weighted`.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 99),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"network_group": schema.StringAttribute{
+				MarkdownDescription: "The WAN interface of the gateway. One of `WAN`, `WAN2` or `WAN_LTE_FAILOVER`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("WAN", "WAN2", "WAN_LTE_FAILOVER"),
+				},
+			},
+			"pppoe": defaultNetworkWANPPPoEResourceModel.schema(),
+			"remove_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "When true, running a destroy will delete the WAN network from the controller, " +
+					"otherwise the WAN network is just removed from state.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the network belongs to. Setting this overrides the default site set in " +
+					"the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"smart_queue":        defaultNetworkWANSmartQueueResourceModel.schema(),
+			"static_ip_settings": defaultNetworkWANStaticIPSettingsResourceModel.schema(),
+			"type": schema.StringAttribute{
+				MarkdownDescription: "How the WAN is addressed. One of `dhcp`, `static` or `pppoe`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(configNetworkTypeDHCP, configNetworkTypeStatic, wanTypePPPoE),
+					customvalidator.StringValueWithPaths(configNetworkTypeStatic, path.MatchRoot("static_ip_settings")),
+					customvalidator.StringValueWithPaths(wanTypePPPoE, path.MatchRoot("pppoe")),
+					customvalidator.StringValueConflictsWithPaths(configNetworkTypeDHCP,
+						path.MatchRoot("pppoe"),
+						path.MatchRoot("static_ip_settings"),
+					),
+					customvalidator.StringValueConflictsWithPaths(configNetworkTypeStatic, path.MatchRoot("pppoe")),
+					customvalidator.StringValueConflictsWithPaths(wanTypePPPoE, path.MatchRoot("static_ip_settings")),
+				},
+			},
+			"vlan_id": schema.Int32Attribute{
+				MarkdownDescription: "Tag the WAN traffic with this VLAN ID. Some ISPs require this.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 4094),
+				},
+			},
+		},
+	}
+}
+
+// validate checks the load balance weight is only set for weighted WANs. load_balance_type defaults to failover-only,
+// which the schema validators can't see when it isn't set.
+func (m *NetworkWANResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	weighted := m.LoadBalanceType.ValueString() == wanLoadBalanceTypeWeighted
+	if !m.LoadBalanceType.IsUnknown() && !weighted && !m.LoadBalanceWeight.IsNull() {
+		diags.AddAttributeError(
+			path.Root("load_balance_weight"),
+			"Invalid Attribute Combination",
+			"load_balance_weight can only be set when load_balance_type is weighted.",
+		)
+	}
+
+	return diags
+}
+
+func (m *NetworkWANResourceModel) toUnifiNetwork(ctx context.Context, network *unifi.Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	network.Name = m.Name.ValueStringPointer()
+	network.WANType = m.Type.ValueStringPointer()
+	network.WANVLANEnabled = !m.VLANID.IsNull()
+	network.WANVLAN = utils.IntPtrValue(m.VLANID.ValueInt32Pointer())
+
+	network.WANLoadBalanceType = m.LoadBalanceType.ValueStringPointer()
+	network.WANLoadBalanceWeight = utils.IntPtrValue(m.LoadBalanceWeight.ValueInt32Pointer())
+
+	var dnsServers []string
+	diags.Append(utils.ListValueStrings(ctx, m.DNSServers, &dnsServers)...)
+	network.WANDNSPreference = utils.StringPtr(wanDNSPreferenceAuto)
+	if len(dnsServers) > 0 {
+		network.WANDNSPreference = utils.StringPtr(wanDNSPreferenceManual)
+	}
+	network.WANDNS1, network.WANDNS2 = utils.IndexString(dnsServers, 0), utils.IndexString(dnsServers, 1)
+
+	m.PPPoE.toUnifiNetwork(network)
+	m.SmartQueue.toUnifiNetwork(network)
+	m.StaticIPSettings.toUnifiNetwork(network)
+
+	return diags
+}
+
+func newNetworkWANResourceModel(ctx context.Context, network *unifi.Network, site string, model NetworkWANResourceModel) (NetworkWANResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(network.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(network.SiteID)
+
+	// Only known to Terraform, so it needs setting when importing.
+	if model.RemoveOnDestroy.IsNull() {
+		model.RemoveOnDestroy = types.BoolValue(false)
+	}
+
+	// Configurable Values
+	model.Name = types.StringPointerValue(network.Name)
+	model.NetworkGroup = types.StringPointerValue(network.WANNetworkGroup)
+	model.Type = types.StringPointerValue(network.WANType)
+
+	model.VLANID = types.Int32Null()
+	if network.WANVLANEnabled {
+		model.VLANID = types.Int32PointerValue(utils.Int32PtrValue(network.WANVLAN))
+	}
+
+	model.LoadBalanceType = types.StringPointerValue(network.WANLoadBalanceType)
+	model.LoadBalanceWeight = types.Int32Null()
+	if model.LoadBalanceType.ValueString() == wanLoadBalanceTypeWeighted {
+		model.LoadBalanceWeight = types.Int32PointerValue(utils.Int32PtrValue(network.WANLoadBalanceWeight))
+	}
+
+	model.DNSServers = types.ListNull(iptypes.IPv4AddressType{})
+	if network.WANDNSPreference != nil && *network.WANDNSPreference == wanDNSPreferenceManual {
+		var d diag.Diagnostics
+		model.DNSServers, d = utils.AddressListValue(ctx, network.WANDNS1, network.WANDNS2)
+		diags.Append(d...)
+	}
+
+	model.PPPoE = newNetworkWANPPPoEResourceModel(network, model.PPPoE)
+	model.SmartQueue = newNetworkWANSmartQueueResourceModel(network, model.SmartQueue)
+	model.StaticIPSettings = newNetworkWANStaticIPSettingsResourceModel(network, model.StaticIPSettings)
+
+	return model, diags
+}
+
+type NetworkWANPPPoEResourceModel struct {
+	Password types.String `tfsdk:"password"`
+	Username types.String `tfsdk:"username"`
+}
+
+func (m *NetworkWANPPPoEResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The PPPoE credentials. Required when `type` is `pppoe`.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (m *NetworkWANPPPoEResourceModel) toUnifiNetwork(network *unifi.Network) {
+	if m == nil {
+		network.WANUsername = ""
+		network.XWANPassword = ""
+		return
+	}
+
+	network.WANUsername = m.Username.ValueString()
+	network.XWANPassword = m.Password.ValueString()
+}
+
+func newNetworkWANPPPoEResourceModel(network *unifi.Network, model *NetworkWANPPPoEResourceModel) *NetworkWANPPPoEResourceModel {
+	if network.WANType == nil || *network.WANType != wanTypePPPoE {
+		return nil
+	}
+
+	if model == nil {
+		model = &NetworkWANPPPoEResourceModel{}
+	}
+
+	model.Username = types.StringValue(network.WANUsername)
+
+	// The password isn't always returned, so keep the configured value when it's missing.
+	if network.XWANPassword != "" || model.Password.IsNull() {
+		model.Password = types.StringValue(network.XWANPassword)
+	}
+
+	return model
+}
+
+type NetworkWANSmartQueueResourceModel struct {
+	DownloadRate types.Int32 `tfsdk:"download_rate"`
+	UploadRate   types.Int32 `tfsdk:"upload_rate"`
+}
+
+func (m *NetworkWANSmartQueueResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Enable smart queues to reduce latency when the WAN is saturated. Rates are in kbps.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"download_rate": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 1000000),
+				},
+			},
+			"upload_rate": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 1000000),
+				},
+			},
+		},
+	}
+}
+
+func (m *NetworkWANSmartQueueResourceModel) toUnifiNetwork(network *unifi.Network) {
+	if m == nil {
+		network.WANSmartqEnabled = false
+		return
+	}
+
+	network.WANSmartqEnabled = true
+	network.WANSmartqDownRate = utils.IntPtrValue(m.DownloadRate.ValueInt32Pointer())
+	network.WANSmartqUpRate = utils.IntPtrValue(m.UploadRate.ValueInt32Pointer())
+}
+
+func newNetworkWANSmartQueueResourceModel(network *unifi.Network, model *NetworkWANSmartQueueResourceModel) *NetworkWANSmartQueueResourceModel {
+	if !network.WANSmartqEnabled {
+		return nil
+	}
+
+	if model == nil {
+		model = &NetworkWANSmartQueueResourceModel{}
+	}
+
+	model.DownloadRate = types.Int32PointerValue(utils.Int32PtrValue(network.WANSmartqDownRate))
+	model.UploadRate = types.Int32PointerValue(utils.Int32PtrValue(network.WANSmartqUpRate))
+
+	return model
+}
+
+type NetworkWANStaticIPSettingsResourceModel struct {
+	Gateway iptypes.IPv4Address `tfsdk:"gateway"`
+	IP      iptypes.IPv4Address `tfsdk:"ip"`
+	Netmask iptypes.IPv4Address `tfsdk:"netmask"`
+}
+
+func (m *NetworkWANStaticIPSettingsResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The static addressing of the WAN. Required when `type` is `static`.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"gateway": schema.StringAttribute{
+				Required:   true,
+				CustomType: iptypes.IPv4AddressType{},
+			},
+			"ip": schema.StringAttribute{
+				Required:   true,
+				CustomType: iptypes.IPv4AddressType{},
+			},
+			"netmask": schema.StringAttribute{
+				Required:   true,
+				CustomType: iptypes.IPv4AddressType{},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(netmaskRegexp, "invalid net mask"),
+				},
+			},
+		},
+	}
+}
+
+func (m *NetworkWANStaticIPSettingsResourceModel) toUnifiNetwork(network *unifi.Network) {
+	if m == nil {
+		network.WANGateway = nil
+		network.WANIP = nil
+		network.WANNetmask = nil
+		return
+	}
+
+	network.WANGateway = m.Gateway.ValueStringPointer()
+	network.WANIP = m.IP.ValueStringPointer()
+	network.WANNetmask = m.Netmask.ValueStringPointer()
+}
+
+func newNetworkWANStaticIPSettingsResourceModel(network *unifi.Network, model *NetworkWANStaticIPSettingsResourceModel) *NetworkWANStaticIPSettingsResourceModel {
+	if network.WANType == nil || *network.WANType != configNetworkTypeStatic {
+		return nil
+	}
+
+	if model == nil {
+		model = &NetworkWANStaticIPSettingsResourceModel{}
+	}
+
+	model.Gateway = iptypes.NewIPv4AddressPointerValue(network.WANGateway)
+	model.IP = iptypes.NewIPv4AddressPointerValue(network.WANIP)
+	model.Netmask = iptypes.NewIPv4AddressPointerValue(network.WANNetmask)
+
+	return model
+}
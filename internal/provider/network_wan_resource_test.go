@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccNetworkWANResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network_wan" "test" {
+  name          = "Test WAN"
+  network_group = "WAN2"
+  type          = "static"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network_wan" "test" {
+  name              = "Test WAN"
+  network_group     = "WAN2"
+  type              = "dhcp"
+  load_balance_type = "weighted"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network_wan" "test" {
+  name                = "Test WAN"
+  network_group       = "WAN2"
+  type                = "dhcp"
+  load_balance_weight = 50
+}
+`,
+				ExpectError: regexp.MustCompile(`load_balance_weight can only be set when load_balance_type is weighted`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkWANResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network_wan" "test" {
+  name              = "Test WAN"
+  network_group     = "WAN2"
+  type              = "dhcp"
+  remove_on_destroy = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network_wan.test", "type", "dhcp"),
+					resource.TestCheckResourceAttr("unifi_network_wan.test", "load_balance_type", "failover-only"),
+					resource.TestCheckNoResourceAttr("unifi_network_wan.test", "load_balance_weight"),
+					resource.TestCheckNoResourceAttr("unifi_network_wan.test", "static_ip_settings"),
+					resource.TestCheckNoResourceAttr("unifi_network_wan.test", "smart_queue"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "unifi_network_wan.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remove_on_destroy"},
+			},
+			// Update and Read testing
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_network_wan" "test" {
+  name                = "Test WAN"
+  network_group       = "WAN2"
+  type                = "static"
+  vlan_id             = 101
+  load_balance_type   = "weighted"
+  load_balance_weight = 25
+  dns_servers         = ["1.1.1.1"]
+  remove_on_destroy   = true
+
+  static_ip_settings = {
+    ip      = "192.0.2.10"
+    netmask = "255.255.255.0"
+    gateway = "192.0.2.1"
+  }
+
+  smart_queue = {
+    download_rate = 50000
+    upload_rate   = 10000
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_network_wan.test", "type", "static"),
+					resource.TestCheckResourceAttr("unifi_network_wan.test", "vlan_id", "101"),
+					resource.TestCheckResourceAttr("unifi_network_wan.test", "load_balance_weight", "25"),
+					resource.TestCheckResourceAttr("unifi_network_wan.test", "dns_servers.0", "1.1.1.1"),
+					resource.TestCheckResourceAttr("unifi_network_wan.test", "static_ip_settings.ip", "192.0.2.10"),
+					resource.TestCheckResourceAttr("unifi_network_wan.test", "smart_queue.download_rate", "50000"),
+				),
+			},
+		},
+	})
+}
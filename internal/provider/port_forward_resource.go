@@ -0,0 +1,469 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customplanmodifier"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+)
+
+const (
+	portForwardSourceLimitingFirewallGroup = "firewall_group"
+	portForwardSourceLimitingIP            = "ip"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &PortForwardResource{}
+	_ resource.ResourceWithImportState    = &PortForwardResource{}
+	_ resource.ResourceWithValidateConfig = &PortForwardResource{}
+
+	defaultPortForwardResourceModel = PortForwardResourceModel{}
+
+	// portRangeRegexp matches a single port or a range of ports, e.g. `8000-8080`.
+	portRangeRegexp = regexp.MustCompile(`^\d{1,5}(-\d{1,5})?$`)
+)
+
+func NewPortForwardResource() resource.Resource {
+	return &PortForwardResource{}
+}
+
+// PortForwardResource defines the resource implementation.
+type PortForwardResource struct {
+	client *unifiClient
+}
+
+func (r *PortForwardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_port_forward"
+}
+
+func (r *PortForwardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultPortForwardResourceModel.schema()
+}
+
+func (r *PortForwardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PortForwardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PortForwardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+func (r *PortForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PortForwardResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	portForward := &unifi.PortForward{
+		DestinationIP: utils.StringPtr("any"),
+	}
+
+	data.toUnifiPortForward(portForward)
+
+	portForward, err := r.client.CreatePortForward(ctx, site, portForward)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create port forward, got error: %s", err))
+		return
+	}
+
+	data = newPortForwardResourceModel(portForward, site, data)
+
+	tflog.Trace(ctx, "Port forward created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PortForwardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PortForwardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	portForward, err := r.client.GetPortForward(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read port forward, got error: %s", err))
+		return
+	}
+
+	data = newPortForwardResourceModel(portForward, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PortForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PortForwardResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current port forward so settings that aren't managed by the resource are left untouched.
+	portForward, err := r.client.GetPortForward(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read port forward, got error: %s", err))
+		return
+	}
+
+	data.toUnifiPortForward(portForward)
+
+	portForward, err = r.client.UpdatePortForward(ctx, site, portForward)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update port forward, got error: %s", err))
+		return
+	}
+
+	data = newPortForwardResourceModel(portForward, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PortForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PortForwardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeletePortForward(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete port forward, got error: %s", err))
+		return
+	}
+}
+
+func (r *PortForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type PortForwardResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	Enabled               types.Bool           `tfsdk:"enabled"`
+	ExternalPort          types.String         `tfsdk:"external_port"`
+	ForwardIP             iptypes.IPv4Address  `tfsdk:"forward_ip"`
+	ForwardPort           types.String         `tfsdk:"forward_port"`
+	Logging               types.Bool           `tfsdk:"logging"`
+	Name                  types.String         `tfsdk:"name"`
+	Protocol              types.String         `tfsdk:"protocol"`
+	Site                  types.String         `tfsdk:"site"`
+	SourceCIDR            cidrtypes.IPv4Prefix `tfsdk:"source_cidr"`
+	SourceFirewallGroupID types.String         `tfsdk:"source_firewall_group_id"`
+	WANInterface          types.String         `tfsdk:"wan_interface"`
+}
+
+func (m *PortForwardResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi port forward, which forwards traffic arriving on a WAN interface to a host on " +
+			"the local network.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi port forward identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"external_port": schema.StringAttribute{
+				MarkdownDescription: "The port, or range of ports such as `8000-8080`, traffic arrives on at the WAN " +
+					"interface.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(portRangeRegexp, "must be a port or port range"),
+				},
+			},
+			"forward_ip": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 address of the host to forward traffic to.",
+				CustomType:          iptypes.IPv4AddressType{},
+				Required:            true,
+			},
+			"forward_port": schema.StringAttribute{
+				MarkdownDescription: "The port, or range of ports, to forward traffic to. A range must cover the same " +
+					"number of ports as `external_port`. Defaults to `external_port`.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					customplanmodifier.StringDefaultToPath(path.MatchRoot("external_port")),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(portRangeRegexp, "must be a port or port range"),
+				},
+			},
+			"logging": schema.BoolAttribute{
+				MarkdownDescription: "When true, forwarded traffic is logged.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol to forward. One of `tcp`, `udp` or `tcp_udp`. Default: `tcp_udp`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("tcp_udp"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("tcp", "udp", "tcp_udp"),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the port forward belongs to. Setting this overrides the default site " +
+					"set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_cidr": schema.StringAttribute{
+				MarkdownDescription: "Only forward traffic from this IPv4 CIDR. When neither this nor " +
+					"`source_firewall_group_id` are set traffic from any source is forwarded.",
+				CustomType: cidrtypes.IPv4PrefixType{},
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("source_firewall_group_id")),
+				},
+			},
+			"source_firewall_group_id": schema.StringAttribute{
+				MarkdownDescription: "Only forward traffic from the addresses in this firewall group.",
+				Optional:            true,
+			},
+			"wan_interface": schema.StringAttribute{
+				MarkdownDescription: "The WAN interface to forward traffic from. One of `wan`, `wan2` or `both`. " +
+					"Default: `wan`",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString("wan"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("wan", "wan2", "both"),
+				},
+			},
+		},
+	}
+}
+
+// validate checks the external and forwarded ports are valid ranges that cover the same number of ports, as the
+// controller maps them one to one.
+func (m *PortForwardResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ports := []struct {
+		name  string
+		value types.String
+	}{
+		{name: "external_port", value: m.ExternalPort},
+		{name: "forward_port", value: m.ForwardPort},
+	}
+
+	// Check each port on its own first, as forward_port is usually left to default to external_port.
+	for _, port := range ports {
+		if port.value.IsNull() || port.value.IsUnknown() {
+			continue
+		}
+
+		start, end, ok := parsePortRange(port.value.ValueString())
+		if !ok {
+			diags.AddAttributeError(
+				path.Root(port.name),
+				"Invalid Port Forward",
+				fmt.Sprintf("Ports must be between 1 and 65535, got: %s.", port.value.ValueString()),
+			)
+		} else if end < start {
+			diags.AddAttributeError(
+				path.Root(port.name),
+				"Invalid Port Forward",
+				fmt.Sprintf("The end of the port range %s must not be before the start.", port.value.ValueString()),
+			)
+		}
+	}
+
+	if diags.HasError() || m.ExternalPort.IsNull() || m.ExternalPort.IsUnknown() || m.ForwardPort.IsNull() ||
+		m.ForwardPort.IsUnknown() {
+		return diags
+	}
+
+	externalStart, externalEnd, _ := parsePortRange(m.ExternalPort.ValueString())
+	forwardStart, forwardEnd, _ := parsePortRange(m.ForwardPort.ValueString())
+	if externalEnd-externalStart != forwardEnd-forwardStart {
+		diags.AddAttributeError(
+			path.Root("forward_port"),
+			"Invalid Port Forward",
+			fmt.Sprintf("The forwarded ports (%s) must cover the same number of ports as the external ports (%s), "+
+				"got %d and %d.", m.ForwardPort.ValueString(), m.ExternalPort.ValueString(),
+				forwardEnd-forwardStart+1, externalEnd-externalStart+1),
+		)
+	}
+
+	return diags
+}
+
+func (m *PortForwardResourceModel) toUnifiPortForward(portForward *unifi.PortForward) {
+	portForward.DstPort = m.ExternalPort.ValueStringPointer()
+	portForward.Enabled = m.Enabled.ValueBool()
+	portForward.Fwd = m.ForwardIP.ValueStringPointer()
+	portForward.Log = m.Logging.ValueBool()
+	portForward.Name = m.Name.ValueStringPointer()
+	portForward.PfwdInterface = m.WANInterface.ValueStringPointer()
+	portForward.Proto = m.Protocol.ValueStringPointer()
+
+	portForward.FwdPort = m.ExternalPort.ValueStringPointer()
+	if !m.ForwardPort.IsNull() && !m.ForwardPort.IsUnknown() {
+		portForward.FwdPort = m.ForwardPort.ValueStringPointer()
+	}
+
+	portForward.Src = utils.StringPtr("any")
+	portForward.SrcFirewallGroupID = ""
+	portForward.SrcLimitingEnabled = false
+	portForward.SrcLimitingType = nil
+
+	switch {
+	case !m.SourceCIDR.IsNull():
+		portForward.Src = m.SourceCIDR.ValueStringPointer()
+		portForward.SrcLimitingEnabled = true
+		portForward.SrcLimitingType = utils.StringPtr(portForwardSourceLimitingIP)
+	case !m.SourceFirewallGroupID.IsNull():
+		portForward.SrcFirewallGroupID = m.SourceFirewallGroupID.ValueString()
+		portForward.SrcLimitingEnabled = true
+		portForward.SrcLimitingType = utils.StringPtr(portForwardSourceLimitingFirewallGroup)
+	}
+}
+
+func newPortForwardResourceModel(portForward *unifi.PortForward, site string, model PortForwardResourceModel) PortForwardResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(portForward.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(portForward.SiteID)
+
+	// Configurable Values
+	model.Enabled = types.BoolValue(portForward.Enabled)
+	model.ExternalPort = types.StringPointerValue(portForward.DstPort)
+	model.ForwardIP = iptypes.NewIPv4AddressPointerValue(portForward.Fwd)
+	model.ForwardPort = types.StringPointerValue(portForward.FwdPort)
+	model.Logging = types.BoolValue(portForward.Log)
+	model.Name = types.StringPointerValue(portForward.Name)
+	model.Protocol = types.StringPointerValue(portForward.Proto)
+	model.WANInterface = types.StringPointerValue(portForward.PfwdInterface)
+
+	model.SourceCIDR = cidrtypes.NewIPv4PrefixNull()
+	model.SourceFirewallGroupID = types.StringNull()
+	if portForward.SrcLimitingEnabled {
+		switch utils.EmptyStringNull(portForward.SrcLimitingType).ValueString() {
+		case portForwardSourceLimitingIP:
+			model.SourceCIDR = cidrtypes.NewIPv4PrefixPointerValue(portForward.Src)
+		case portForwardSourceLimitingFirewallGroup:
+			model.SourceFirewallGroupID = utils.EmptyStringNull(&portForward.SrcFirewallGroupID)
+		}
+	}
+
+	return model
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccPortForwardResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPortForwardConfig("8000-8010", `forward_port = "9000-9005"`),
+				ExpectError: regexp.MustCompile(`must cover the same number of ports`),
+			},
+			{
+				Config:      testAccPortForwardConfig("8010-8000", ""),
+				ExpectError: regexp.MustCompile(`must not be before the start`),
+			},
+			{
+				Config: testAccPortForwardConfig("443", `
+  source_cidr              = "203.0.113.0/24"
+  source_firewall_group_id = "66a5357b30079358c34fe5d9"
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccPortForwardResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPortForwardConfig("443", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "name", "Test Forward"),
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "external_port", "443"),
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "forward_port", "443"),
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "protocol", "tcp_udp"),
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "wan_interface", "wan"),
+					resource.TestCheckNoResourceAttr("unifi_port_forward.test", "source_cidr"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_port_forward.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccPortForwardConfig("8000-8010", `
+  forward_port = "9000-9010"
+  protocol     = "tcp"
+  logging      = true
+  source_cidr  = "203.0.113.0/24"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "external_port", "8000-8010"),
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "forward_port", "9000-9010"),
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "logging", "true"),
+					resource.TestCheckResourceAttr("unifi_port_forward.test", "source_cidr", "203.0.113.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPortForwardConfig(externalPort, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_port_forward" "test" {
+  name          = "Test Forward"
+  external_port = %q
+  forward_ip    = "192.168.1.10"
+  %s
+}
+`, externalPort, settings)
+}
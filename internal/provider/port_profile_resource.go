@@ -0,0 +1,619 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+)
+
+const (
+	stormControlTypeLevel = "level"
+	stormControlTypeRate  = "rate"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &PortProfileResource{}
+	_ resource.ResourceWithImportState    = &PortProfileResource{}
+	_ resource.ResourceWithValidateConfig = &PortProfileResource{}
+
+	defaultPortProfileResourceModel             = PortProfileResourceModel{}
+	defaultPortProfileStormControlResourceModel = PortProfileStormControlResourceModel{}
+)
+
+func NewPortProfileResource() resource.Resource {
+	return &PortProfileResource{}
+}
+
+// PortProfileResource defines the resource implementation.
+type PortProfileResource struct {
+	client *unifiClient
+}
+
+func (r *PortProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_port_profile"
+}
+
+func (r *PortProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultPortProfileResourceModel.schema()
+}
+
+func (r *PortProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PortProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PortProfileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.StormControl.validate()...)
+}
+
+func (r *PortProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PortProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	profile := &unifi.PortProfile{
+		OpMode:      utils.StringPtr("switch"),
+		StpPortMode: true,
+	}
+
+	resp.Diagnostics.Append(data.toUnifiPortProfile(ctx, profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.CreatePortProfile(ctx, site, profile)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create port profile, got error: %s", err))
+		return
+	}
+
+	data, diags := newPortProfileResourceModel(ctx, profile, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "Port profile created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PortProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PortProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	profile, err := r.client.GetPortProfile(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read port profile, got error: %s", err))
+		return
+	}
+
+	data, diags := newPortProfileResourceModel(ctx, profile, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PortProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PortProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current profile so settings that aren't managed by the resource are left untouched.
+	profile, err := r.client.GetPortProfile(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read port profile, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiPortProfile(ctx, profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err = r.client.UpdatePortProfile(ctx, site, profile)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update port profile, got error: %s", err))
+		return
+	}
+
+	data, diags := newPortProfileResourceModel(ctx, profile, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PortProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PortProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeletePortProfile(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete port profile, got error: %s", err))
+		return
+	}
+}
+
+func (r *PortProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type PortProfileResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	Dot1XControl             types.String                          `tfsdk:"dot1x_control"`
+	Dot1XIdleTimeout         types.Int32                           `tfsdk:"dot1x_idle_timeout"`
+	EgressRateLimit          types.Int32                           `tfsdk:"egress_rate_limit_kbps"`
+	ExcludedTaggedNetworkIds types.List                            `tfsdk:"excluded_tagged_network_ids"`
+	FullDuplex               types.Bool                            `tfsdk:"full_duplex"`
+	Isolation                types.Bool                            `tfsdk:"isolation"`
+	LinkSpeed                types.Int32                           `tfsdk:"link_speed"`
+	LLDPMEDEnabled           types.Bool                            `tfsdk:"lldp_med_enabled"`
+	LLDPMEDNotifyEnabled     types.Bool                            `tfsdk:"lldp_med_notify_enabled"`
+	Name                     types.String                          `tfsdk:"name"`
+	NativeNetworkID          types.String                          `tfsdk:"native_network_id"`
+	POEMode                  types.String                          `tfsdk:"poe_mode"`
+	Site                     types.String                          `tfsdk:"site"`
+	StormControl             *PortProfileStormControlResourceModel `tfsdk:"storm_control"`
+	TaggedVLANManagement     types.String                          `tfsdk:"tagged_vlan_management"`
+	VoiceNetworkID           types.String                          `tfsdk:"voice_network_id"`
+}
+
+func (m *PortProfileResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi switch port profile. Profiles are assigned to switch ports with the " +
+			"`port_profile_id` of a port override.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi port profile identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"dot1x_control": schema.StringAttribute{
+				MarkdownDescription: "The 802.1X control mode of the port. One of `auto`, `force_authorized`, " +
+					"`force_unauthorized`, `mac_based` or `multi_host`. Default: `force_authorized`",
+				Computed:   true,
+				Optional:   true,
+				Default:    stringdefault.StaticString("force_authorized"),
+				Validators: portDot1XControlValidators(),
+			},
+			"dot1x_idle_timeout": schema.Int32Attribute{
+				MarkdownDescription: "The number of seconds before an idle 802.1X authorised client is removed. " +
+					"Default: `300`",
+				Computed:   true,
+				Optional:   true,
+				Default:    int32default.StaticInt32(300),
+				Validators: portDot1XIdleTimeoutValidators(),
+			},
+			"egress_rate_limit_kbps": schema.Int32Attribute{
+				MarkdownDescription: "Sets a port's maximum rate of data transfer.",
+				Optional:            true,
+				Validators:          portEgressRateLimitValidators(),
+			},
+			"excluded_tagged_network_ids": schema.ListAttribute{
+				MarkdownDescription: "The networks that are not tagged on ports using the profile when " +
+					"`tagged_vlan_management` is `custom`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  portExcludedTaggedNetworkIDsValidators(),
+			},
+			"full_duplex": schema.BoolAttribute{
+				Optional:   true,
+				Validators: portFullDuplexValidators(),
+			},
+			"isolation": schema.BoolAttribute{
+				MarkdownDescription: "Allows you to prohibit traffic between isolated ports. This only applies to " +
+					"ports on the same device.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"link_speed": schema.Int32Attribute{
+				MarkdownDescription: "An override for the link speed of the port.",
+				Optional:            true,
+				Validators:          portLinkSpeedValidators(),
+			},
+			"lldp_med_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Extension for LLPD user alongside the voice VLAN feature to discover the " +
+					"presence of a VoIP phone. Disabling LLPD-MED will also disable the Voice VLAN.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"lldp_med_notify_enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"native_network_id": schema.StringAttribute{
+				MarkdownDescription: "The native network used for VLAN traffic, i.e. not tagged with a VLAN ID. " +
+					"Setting this to an empty string (which this defaults to) will prevent untagged traffic from " +
+					"being placed in to a VLAN by default.",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"poe_mode": schema.StringAttribute{
+				Computed:   true,
+				Optional:   true,
+				Default:    stringdefault.StaticString("auto"),
+				Validators: portPOEModeValidators(),
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the port profile belongs to. Setting this overrides the default site " +
+					"set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storm_control": defaultPortProfileStormControlResourceModel.schema(),
+			"tagged_vlan_management": schema.StringAttribute{
+				Computed:   true,
+				Optional:   true,
+				Default:    stringdefault.StaticString(taggedVLANManagementAuto),
+				Validators: portTaggedVLANManagementValidators(),
+			},
+			"voice_network_id": schema.StringAttribute{
+				MarkdownDescription: "Uses LLPD-MED to place a VoIP phone on the specified VLAN. Devices connected " +
+					"to the phone are placed in the native VLAN.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (m *PortProfileResourceModel) toUnifiPortProfile(ctx context.Context, profile *unifi.PortProfile) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	profile.Autoneg = m.LinkSpeed.IsNull()
+	profile.SettingPreference = utils.StringPtr(portOverrideSettingPreferenceAuto)
+	if !m.LinkSpeed.IsNull() {
+		profile.SettingPreference = utils.StringPtr(portOverrideSettingPreferenceManual)
+	}
+
+	profile.Dot1XCtrl = m.Dot1XControl.ValueStringPointer()
+	profile.Dot1XIDleTimeout = utils.IntPtrValue(m.Dot1XIdleTimeout.ValueInt32Pointer())
+	profile.EgressRateLimitKbpsEnabled = !m.EgressRateLimit.IsNull()
+	profile.EgressRateLimitKbps = utils.IntPtrValue(m.EgressRateLimit.ValueInt32Pointer())
+	profile.FullDuplex = m.FullDuplex.ValueBool()
+	profile.Isolation = m.Isolation.ValueBool()
+	profile.LldpmedEnabled = m.LLDPMEDEnabled.ValueBool()
+	profile.LldpmedNotifyEnabled = m.LLDPMEDNotifyEnabled.ValueBool()
+	profile.Name = m.Name.ValueStringPointer()
+	profile.NATiveNetworkID = m.NativeNetworkID.ValueString()
+	profile.PoeMode = m.POEMode.ValueStringPointer()
+	profile.Speed = utils.IntPtrValue(m.LinkSpeed.ValueInt32Pointer())
+	profile.TaggedVLANMgmt = m.TaggedVLANManagement.ValueStringPointer()
+	profile.VoiceNetworkID = m.VoiceNetworkID.ValueString()
+
+	// Older controllers use forward rather than tagged VLAN management so keep them in sync.
+	switch m.TaggedVLANManagement.ValueString() {
+	case taggedVLANManagementAuto:
+		profile.Forward = utils.StringPtr("all")
+	case taggedVLANManagementBlockAll:
+		profile.Forward = utils.StringPtr("native")
+	case taggedVLANManagementCustom:
+		profile.Forward = utils.StringPtr("customize")
+	}
+
+	excludedNetworkIDs := make([]string, 0, len(m.ExcludedTaggedNetworkIds.Elements()))
+	diags.Append(utils.ListValueStrings(ctx, m.ExcludedTaggedNetworkIds, &excludedNetworkIDs)...)
+	profile.ExcludedNetworkIDs = &excludedNetworkIDs
+
+	m.StormControl.toUnifiPortProfile(profile)
+
+	return diags
+}
+
+func newPortProfileResourceModel(ctx context.Context, profile *unifi.PortProfile, site string, model PortProfileResourceModel) (PortProfileResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(profile.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(profile.SiteID)
+
+	// Configurable Values
+	model.Dot1XControl = types.StringPointerValue(profile.Dot1XCtrl)
+	model.Dot1XIdleTimeout = types.Int32PointerValue(utils.Int32PtrValue(profile.Dot1XIDleTimeout))
+	model.Isolation = types.BoolValue(profile.Isolation)
+	model.LLDPMEDEnabled = types.BoolValue(profile.LldpmedEnabled)
+	model.LLDPMEDNotifyEnabled = types.BoolValue(profile.LldpmedNotifyEnabled)
+	model.Name = types.StringPointerValue(profile.Name)
+	model.NativeNetworkID = types.StringValue(profile.NATiveNetworkID)
+	model.POEMode = types.StringPointerValue(profile.PoeMode)
+	model.TaggedVLANManagement = types.StringPointerValue(profile.TaggedVLANMgmt)
+	model.VoiceNetworkID = types.StringNull()
+	if profile.VoiceNetworkID != "" {
+		model.VoiceNetworkID = types.StringValue(profile.VoiceNetworkID)
+	}
+
+	model.EgressRateLimit = types.Int32Null()
+	if profile.EgressRateLimitKbpsEnabled {
+		model.EgressRateLimit = types.Int32PointerValue(utils.Int32PtrValue(profile.EgressRateLimitKbps))
+	}
+
+	model.FullDuplex = types.BoolNull()
+	model.LinkSpeed = types.Int32Null()
+	if !profile.Autoneg {
+		model.FullDuplex = types.BoolValue(profile.FullDuplex)
+		model.LinkSpeed = types.Int32PointerValue(utils.Int32PtrValue(profile.Speed))
+	}
+
+	model.ExcludedTaggedNetworkIds = types.ListNull(types.StringType)
+	if profile.ExcludedNetworkIDs != nil && len(*profile.ExcludedNetworkIDs) > 0 {
+		var d diag.Diagnostics
+		model.ExcludedTaggedNetworkIds, d = types.ListValueFrom(ctx, types.StringType, *profile.ExcludedNetworkIDs)
+		diags.Append(d...)
+	}
+
+	model.StormControl = newPortProfileStormControlResourceModel(profile)
+
+	return model, diags
+}
+
+type PortProfileStormControlResourceModel struct {
+	Broadcast types.Int32  `tfsdk:"broadcast"`
+	Multicast types.Int32  `tfsdk:"multicast"`
+	Type      types.String `tfsdk:"type"`
+	Unicast   types.Int32  `tfsdk:"unicast"`
+}
+
+func (m *PortProfileStormControlResourceModel) schema() schema.Attribute {
+	description := func(traffic string) string {
+		return fmt.Sprintf("The limit for %s traffic. When not set %s traffic is not limited.", traffic, traffic)
+	}
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Limit broadcast, multicast and unknown unicast traffic on the port. Limits are a " +
+			"percentage of the link speed when `type` is `level`, or packets per second when `type` is `rate`. At " +
+			"least one limit must be set.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"broadcast": schema.Int32Attribute{
+				MarkdownDescription: description("broadcast"),
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"multicast": schema.Int32Attribute{
+				MarkdownDescription: description("multicast"),
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "One of `level` or `rate`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(stormControlTypeLevel, stormControlTypeRate),
+				},
+			},
+			"unicast": schema.Int32Attribute{
+				MarkdownDescription: description("unknown unicast"),
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+// validate checks at least one limit is set and the limits are in range for the type of storm control. This can't be
+// done with attribute validators as the range depends on the type.
+func (m *PortProfileStormControlResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m == nil {
+		return diags
+	}
+
+	if m.Broadcast.IsNull() && m.Multicast.IsNull() && m.Unicast.IsNull() {
+		diags.AddAttributeError(
+			path.Root("storm_control"),
+			"Missing Storm Control Limit",
+			"At least one of broadcast, multicast or unicast must be set when storm_control is set.",
+		)
+	}
+
+	if m.Type.IsUnknown() {
+		return diags
+	}
+
+	maximum, unit := int32(100), "percent"
+	if m.Type.ValueString() == stormControlTypeRate {
+		maximum, unit = 14880000, "packets per second"
+	}
+
+	for name, limit := range map[string]types.Int32{"broadcast": m.Broadcast, "multicast": m.Multicast, "unicast": m.Unicast} {
+		if limit.IsNull() || limit.IsUnknown() || limit.ValueInt32() <= maximum {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root("storm_control").AtName(name),
+			"Invalid Storm Control Limit",
+			fmt.Sprintf("A %s limit must be at most %d %s, got: %d.", m.Type.ValueString(), maximum, unit, limit.ValueInt32()),
+		)
+	}
+
+	return diags
+}
+
+func (m *PortProfileStormControlResourceModel) toUnifiPortProfile(profile *unifi.PortProfile) {
+	if m == nil {
+		profile.StormctrlBroadcastastEnabled = false
+		profile.StormctrlMcastEnabled = false
+		profile.StormctrlUcastEnabled = false
+		return
+	}
+
+	profile.StormctrlType = m.Type.ValueStringPointer()
+	profile.StormctrlBroadcastastEnabled = !m.Broadcast.IsNull()
+	profile.StormctrlMcastEnabled = !m.Multicast.IsNull()
+	profile.StormctrlUcastEnabled = !m.Unicast.IsNull()
+
+	broadcast := utils.IntPtrValue(m.Broadcast.ValueInt32Pointer())
+	multicast := utils.IntPtrValue(m.Multicast.ValueInt32Pointer())
+	unicast := utils.IntPtrValue(m.Unicast.ValueInt32Pointer())
+	if m.Type.ValueString() == stormControlTypeRate {
+		profile.StormctrlBroadcastastRate = broadcast
+		profile.StormctrlMcastRate = multicast
+		profile.StormctrlUcastRate = unicast
+		return
+	}
+
+	profile.StormctrlBroadcastastLevel = broadcast
+	profile.StormctrlMcastLevel = multicast
+	profile.StormctrlUcastLevel = unicast
+}
+
+func newPortProfileStormControlResourceModel(profile *unifi.PortProfile) *PortProfileStormControlResourceModel {
+	if !profile.StormctrlBroadcastastEnabled && !profile.StormctrlMcastEnabled && !profile.StormctrlUcastEnabled {
+		return nil
+	}
+
+	model := &PortProfileStormControlResourceModel{
+		Broadcast: types.Int32Null(),
+		Multicast: types.Int32Null(),
+		Type:      types.StringPointerValue(profile.StormctrlType),
+		Unicast:   types.Int32Null(),
+	}
+
+	broadcast, multicast, unicast := profile.StormctrlBroadcastastLevel, profile.StormctrlMcastLevel, profile.StormctrlUcastLevel
+	if model.Type.ValueString() == stormControlTypeRate {
+		broadcast, multicast, unicast = profile.StormctrlBroadcastastRate, profile.StormctrlMcastRate, profile.StormctrlUcastRate
+	}
+
+	if profile.StormctrlBroadcastastEnabled {
+		model.Broadcast = types.Int32PointerValue(utils.Int32PtrValue(broadcast))
+	}
+
+	if profile.StormctrlMcastEnabled {
+		model.Multicast = types.Int32PointerValue(utils.Int32PtrValue(multicast))
+	}
+
+	if profile.StormctrlUcastEnabled {
+		model.Unicast = types.Int32PointerValue(utils.Int32PtrValue(unicast))
+	}
+
+	return model
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccPortProfileResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPortProfileConfig(`link_speed = 1000`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccPortProfileConfig(`tagged_vlan_management = "custom"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccPortProfileConfig(`
+  storm_control = {
+    type      = "level"
+    broadcast = 101
+  }
+`),
+				ExpectError: regexp.MustCompile(`A level limit must be at most 100 percent`),
+			},
+			{
+				Config: testAccPortProfileConfig(`
+  storm_control = {
+    type = "rate"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Missing Storm Control Limit`),
+			},
+		},
+	})
+}
+
+func TestAccPortProfileResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPortProfileConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "name", "Test Profile"),
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "poe_mode", "auto"),
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "tagged_vlan_management", "auto"),
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "dot1x_control", "force_authorized"),
+					resource.TestCheckNoResourceAttr("unifi_port_profile.test", "link_speed"),
+					resource.TestCheckNoResourceAttr("unifi_port_profile.test", "storm_control"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_port_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccPortProfileConfig(`
+  link_speed              = 1000
+  full_duplex             = true
+  poe_mode                = "off"
+  isolation               = true
+  egress_rate_limit_kbps  = 10000
+  lldp_med_notify_enabled = true
+  tagged_vlan_management  = "block_all"
+
+  storm_control = {
+    type      = "rate"
+    broadcast = 1000
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "link_speed", "1000"),
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "full_duplex", "true"),
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "poe_mode", "off"),
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "isolation", "true"),
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "egress_rate_limit_kbps", "10000"),
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "tagged_vlan_management", "block_all"),
+					resource.TestCheckResourceAttr("unifi_port_profile.test", "storm_control.broadcast", "1000"),
+					resource.TestCheckNoResourceAttr("unifi_port_profile.test", "storm_control.multicast"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPortProfileConfig(settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_port_profile" "test" {
+  name = "Test Profile"
+  %s
+}
+`, settings)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+)
+
+// The validators in this file are shared by every resource that configures a switch port, i.e. port overrides on a
+// switch and port profiles, so a setting behaves the same no matter where it is applied. Paths are relative to the
+// attribute so they work both at the root of a schema and within a nested object.
+
+const (
+	taggedVLANManagementAuto     = "auto"
+	taggedVLANManagementBlockAll = "block_all"
+	taggedVLANManagementCustom   = "custom"
+)
+
+func portDot1XControlValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf("auto", "force_authorized", "force_unauthorized", "mac_based", "multi_host"),
+	}
+}
+
+func portDot1XIdleTimeoutValidators() []validator.Int32 {
+	return []validator.Int32{
+		int32validator.Between(0, 65535),
+	}
+}
+
+func portEgressRateLimitValidators() []validator.Int32 {
+	return []validator.Int32{
+		int32validator.Between(64, 9999999),
+	}
+}
+
+func portExcludedTaggedNetworkIDsValidators() []validator.List {
+	return []validator.List{
+		listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("tagged_vlan_management")),
+	}
+}
+
+func portFullDuplexValidators() []validator.Bool {
+	return []validator.Bool{
+		boolvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("link_speed")),
+	}
+}
+
+func portLinkSpeedValidators() []validator.Int32 {
+	return []validator.Int32{
+		int32validator.OneOf(10, 100, 1000, 2500, 5000, 10000, 20000, 25000, 40000, 50000, 100000),
+		int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("full_duplex")),
+	}
+}
+
+func portPOEModeValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf("auto", "pasv24", "passthrough", "off"),
+	}
+}
+
+func portTaggedVLANManagementValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf(taggedVLANManagementAuto, taggedVLANManagementBlockAll, taggedVLANManagementCustom),
+		customvalidator.StringValueWithPaths(taggedVLANManagementCustom,
+			path.MatchRelative().AtParent().AtName("excluded_tagged_network_ids"),
+		),
+	}
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/jamestoyer/go-unifi/unifi"
 	"net/http"
 	"net/http/cookiejar"
+	"net/url"
 	"os"
 	"strconv"
+	"sync"
 )
 
 // Ensure UnifiProvider satisfies various provider interfaces.
@@ -36,6 +38,20 @@ type UnifiProvider struct {
 type unifiClient struct {
 	*unifi.Client
 	site string
+
+	// The following are used to make requests to endpoints that are not yet supported by the SDK. See client.go.
+	apiPath     string
+	v2APIPath   string
+	apiPathLock sync.Mutex
+	baseURL     *url.URL
+	httpClient  *http.Client
+
+	// zoneBasedFirewallSites caches whether each site uses zone-based firewalling. See client_firewall_zone.go.
+	zoneBasedFirewallSites map[string]bool
+	firewallLock           sync.Mutex
+
+	// dhcpOptionLock serialises changes to the custom DHCP option values of networks. See client_dhcp_option.go.
+	dhcpOptionLock sync.Mutex
 }
 
 // UnifiProviderModel describes the provider data model.
@@ -193,12 +209,43 @@ func (p *UnifiProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 func (p *UnifiProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAdminResource,
+		NewAPGroupResource,
+		NewClientResource,
 		NewDeviceSwitchResource,
+		NewDHCPOptionResource,
+		NewDNSRecordResource,
+		NewDynamicDNSResource,
+		NewFirewallGroupResource,
+		NewFirewallPolicyResource,
+		NewFirewallRuleResource,
+		NewFirewallZoneResource,
+		NewNetworkResource,
+		NewNetworkWANResource,
+		NewPortForwardResource,
+		NewPortProfileResource,
+		NewRADIUSAccountResource,
+		NewRADIUSProfileResource,
+		NewSettingGatewayResource,
+		NewSettingMgmtResource,
+		NewSettingRADIUSResource,
+		NewSiteVPNResource,
+		NewStaticRouteResource,
+		NewTrafficRouteResource,
+		NewTrafficRuleResource,
+		NewUserGroupResource,
+		NewVPNClientResource,
+		NewVPNServerL2TPResource,
+		NewVPNServerOpenVPNResource,
+		NewVPNServerWireGuardResource,
+		NewWLANResource,
 	}
 }
 
 func (p *UnifiProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAdminsDataSource,
+		NewClientsDataSource,
 		NewDeviceDataSource,
 		NewDeviceSwitchDataSource,
 	}
@@ -231,5 +278,6 @@ func setHTTPClient(c *unifiClient, insecure bool) {
 	jar, _ := cookiejar.New(nil)
 	httpClient.Jar = jar
 
+	c.httpClient = httpClient
 	_ = c.SetHTTPClient(httpClient)
 }
@@ -0,0 +1,316 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &RADIUSAccountResource{}
+	_ resource.ResourceWithImportState = &RADIUSAccountResource{}
+
+	defaultRADIUSAccountResourceModel = RADIUSAccountResourceModel{}
+)
+
+func NewRADIUSAccountResource() resource.Resource {
+	return &RADIUSAccountResource{}
+}
+
+// RADIUSAccountResource defines the resource implementation.
+type RADIUSAccountResource struct {
+	client *unifiClient
+}
+
+func (r *RADIUSAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_radius_account"
+}
+
+func (r *RADIUSAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultRADIUSAccountResourceModel.schema()
+}
+
+func (r *RADIUSAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RADIUSAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RADIUSAccountResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	account := &unifi.Account{}
+	data.toUnifiAccount(account)
+
+	account, err := r.client.CreateAccount(ctx, site, account)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create RADIUS account, got error: %s", err))
+		return
+	}
+
+	data = newRADIUSAccountResourceModel(account, site, data)
+
+	tflog.Trace(ctx, "RADIUS account created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RADIUSAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RADIUSAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	account, err := r.client.GetAccount(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RADIUS account, got error: %s", err))
+		return
+	}
+
+	data = newRADIUSAccountResourceModel(account, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RADIUSAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RADIUSAccountResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current account so settings that aren't managed by the resource are left untouched.
+	account, err := r.client.GetAccount(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RADIUS account, got error: %s", err))
+		return
+	}
+
+	data.toUnifiAccount(account)
+
+	account, err = r.client.UpdateAccount(ctx, site, account)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RADIUS account, got error: %s", err))
+		return
+	}
+
+	data = newRADIUSAccountResourceModel(account, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RADIUSAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RADIUSAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteAccount(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete RADIUS account, got error: %s", err))
+		return
+	}
+}
+
+func (r *RADIUSAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type RADIUSAccountResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	Password         types.String `tfsdk:"password"`
+	Site             types.String `tfsdk:"site"`
+	TunnelMediumType types.Int32  `tfsdk:"tunnel_medium_type"`
+	TunnelType       types.Int32  `tfsdk:"tunnel_type"`
+	Username         types.String `tfsdk:"username"`
+	VLANID           types.Int32  `tfsdk:"vlan_id"`
+}
+
+func (m *RADIUSAccountResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "An account on the built in RADIUS server of the controller. The server is enabled with " +
+			"the `unifi_setting_radius` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi RADIUS account identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the RADIUS account belongs to. Setting this overrides the default site " +
+					"set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tunnel_medium_type": schema.Int32Attribute{
+				MarkdownDescription: "The RFC 2868 tunnel medium type returned with the VLAN. Default: `6`, i.e. " +
+					"802 media",
+				Computed: true,
+				Optional: true,
+				Default:  int32default.StaticInt32(6),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 15),
+				},
+			},
+			"tunnel_type": schema.Int32Attribute{
+				MarkdownDescription: "The RFC 2868 tunnel type returned with the VLAN. Default: `13`, i.e. VLAN",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(13),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 13),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^"' ]+$`), "must not contain quotes or spaces"),
+				},
+			},
+			"vlan_id": schema.Int32Attribute{
+				MarkdownDescription: "The VLAN to place the client in once authenticated.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					// The controller ignores a missing VLAN on update, so the account has to be recreated to clear it.
+					int32planmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.Int32Request, resp *int32planmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = req.PlanValue.IsNull() && !req.StateValue.IsNull()
+					}, "Removing the VLAN requires the account to be recreated.", "Removing the VLAN requires the account to be recreated."),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(2, 4009),
+				},
+			},
+		},
+	}
+}
+
+func (m *RADIUSAccountResourceModel) toUnifiAccount(account *unifi.Account) {
+	account.Name = m.Username.ValueStringPointer()
+	account.TunnelMediumType = utils.IntPtrValue(m.TunnelMediumType.ValueInt32Pointer())
+	account.TunnelType = utils.IntPtrValue(m.TunnelType.ValueInt32Pointer())
+	account.VLAN = utils.IntPtrValue(m.VLANID.ValueInt32Pointer())
+	account.XPassword = m.Password.ValueStringPointer()
+}
+
+func newRADIUSAccountResourceModel(account *unifi.Account, site string, model RADIUSAccountResourceModel) RADIUSAccountResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(account.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(account.SiteID)
+
+	// Configurable Values
+	model.Password = types.StringPointerValue(account.XPassword)
+	model.TunnelMediumType = types.Int32PointerValue(utils.Int32PtrValue(account.TunnelMediumType))
+	model.TunnelType = types.Int32PointerValue(utils.Int32PtrValue(account.TunnelType))
+	model.Username = types.StringPointerValue(account.Name)
+	model.VLANID = types.Int32PointerValue(utils.Int32PtrValue(account.VLAN))
+
+	return model
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccRADIUSAccountResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRADIUSAccountConfig("test user", ""),
+				ExpectError: regexp.MustCompile(`must not contain quotes or spaces`),
+			},
+			{
+				Config:      testAccRADIUSAccountConfig("test", `vlan_id = 1`),
+				ExpectError: regexp.MustCompile(`Attribute vlan_id value must be between 2 and 4009`),
+			},
+		},
+	})
+}
+
+func TestAccRADIUSAccountResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRADIUSAccountConfig("test", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_radius_account.test", "username", "test"),
+					resource.TestCheckResourceAttr("unifi_radius_account.test", "tunnel_medium_type", "6"),
+					resource.TestCheckResourceAttr("unifi_radius_account.test", "tunnel_type", "13"),
+					resource.TestCheckNoResourceAttr("unifi_radius_account.test", "vlan_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_radius_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRADIUSAccountConfig("test", `vlan_id = 20`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_radius_account.test", "vlan_id", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRADIUSAccountConfig(username, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_radius_account" "test" {
+  username = %q
+  password = "test-password"
+  %s
+}
+`, username, settings)
+}
@@ -0,0 +1,423 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &RADIUSProfileResource{}
+	_ resource.ResourceWithImportState = &RADIUSProfileResource{}
+
+	defaultRADIUSProfileResourceModel       = RADIUSProfileResourceModel{}
+	defaultRADIUSProfileServerResourceModel = RADIUSProfileServerResourceModel{}
+)
+
+func NewRADIUSProfileResource() resource.Resource {
+	return &RADIUSProfileResource{}
+}
+
+// RADIUSProfileResource defines the resource implementation.
+type RADIUSProfileResource struct {
+	client *unifiClient
+}
+
+func (r *RADIUSProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_radius_profile"
+}
+
+func (r *RADIUSProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultRADIUSProfileResourceModel.schema()
+}
+
+func (r *RADIUSProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RADIUSProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RADIUSProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	profile := &unifi.RADIUSProfile{}
+	data.toUnifiRADIUSProfile(profile)
+
+	profile, err := r.client.CreateRADIUSProfile(ctx, site, profile)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create RADIUS profile, got error: %s", err))
+		return
+	}
+
+	data = newRADIUSProfileResourceModel(profile, site, data)
+
+	tflog.Trace(ctx, "RADIUS profile created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RADIUSProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RADIUSProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	profile, err := r.client.GetRADIUSProfile(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RADIUS profile, got error: %s", err))
+		return
+	}
+
+	data = newRADIUSProfileResourceModel(profile, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RADIUSProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RADIUSProfileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current profile so settings that aren't managed by the resource are left untouched.
+	profile, err := r.client.GetRADIUSProfile(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RADIUS profile, got error: %s", err))
+		return
+	}
+
+	data.toUnifiRADIUSProfile(profile)
+
+	profile, err = r.client.UpdateRADIUSProfile(ctx, site, profile)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RADIUS profile, got error: %s", err))
+		return
+	}
+
+	data = newRADIUSProfileResourceModel(profile, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RADIUSProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RADIUSProfileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteRADIUSProfile(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete RADIUS profile, got error: %s", err))
+		return
+	}
+}
+
+func (r *RADIUSProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type RADIUSProfileResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	AccountingServers     []RADIUSProfileServerResourceModel `tfsdk:"accounting_servers"`
+	AuthServers           []RADIUSProfileServerResourceModel `tfsdk:"auth_servers"`
+	InterimUpdateInterval types.Int32                        `tfsdk:"interim_update_interval"`
+	Name                  types.String                       `tfsdk:"name"`
+	Site                  types.String                       `tfsdk:"site"`
+	VLANEnabled           types.Bool                         `tfsdk:"vlan_enabled"`
+	WLANVLANMode          types.String                       `tfsdk:"wlan_vlan_mode"`
+}
+
+func (m *RADIUSProfileResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi RADIUS profile, used by WLANs and switch ports to authenticate clients with " +
+			"802.1X.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi RADIUS profile identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"accounting_servers": schema.ListNestedAttribute{
+				MarkdownDescription: "The servers to send accounting records to, in order of preference. When not " +
+					"set accounting is disabled.",
+				NestedObject: defaultRADIUSProfileServerResourceModel.schema(1813),
+				Optional:     true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"auth_servers": schema.ListNestedAttribute{
+				MarkdownDescription: "The servers to authenticate clients with, in order of preference.",
+				NestedObject:        defaultRADIUSProfileServerResourceModel.schema(1812),
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"interim_update_interval": schema.Int32Attribute{
+				MarkdownDescription: "How often, in seconds, interim accounting updates are sent. When not set only " +
+					"the start and end of a session are recorded.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(60, 86400),
+					int32validator.AlsoRequires(path.MatchRoot("accounting_servers")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the RADIUS profile belongs to. Setting this overrides the default site " +
+					"set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vlan_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, wired clients are placed in the VLAN returned by the RADIUS server.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wlan_vlan_mode": schema.StringAttribute{
+				MarkdownDescription: "Whether wireless clients are placed in the VLAN returned by the RADIUS server. " +
+					"One of `disabled`, `optional` or `required`. Default: `disabled`",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString("disabled"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("disabled", "optional", "required"),
+				},
+			},
+		},
+	}
+}
+
+func (m *RADIUSProfileResourceModel) toUnifiRADIUSProfile(profile *unifi.RADIUSProfile) {
+	profile.Name = m.Name.ValueStringPointer()
+	profile.VLANEnabled = m.VLANEnabled.ValueBool()
+	profile.VLANWLANMode = m.WLANVLANMode.ValueStringPointer()
+
+	authServers := make([]unifi.RADIUSProfileAuthServers, 0, len(m.AuthServers))
+	for _, server := range m.AuthServers {
+		authServers = append(authServers, unifi.RADIUSProfileAuthServers{
+			IP:      server.IP.ValueStringPointer(),
+			Port:    utils.IntPtrValue(server.Port.ValueInt32Pointer()),
+			XSecret: server.Secret.ValueStringPointer(),
+		})
+	}
+
+	acctServers := make([]unifi.RADIUSProfileAcctServers, 0, len(m.AccountingServers))
+	for _, server := range m.AccountingServers {
+		acctServers = append(acctServers, unifi.RADIUSProfileAcctServers{
+			IP:      server.IP.ValueStringPointer(),
+			Port:    utils.IntPtrValue(server.Port.ValueInt32Pointer()),
+			XSecret: server.Secret.ValueStringPointer(),
+		})
+	}
+
+	profile.AuthServers = &authServers
+	profile.AcctServers = &acctServers
+	profile.AccountingEnabled = len(acctServers) > 0
+	profile.UseUsgAuthServer = false
+	profile.UseUsgAcctServer = false
+
+	profile.InterimUpdateEnabled = !m.InterimUpdateInterval.IsNull()
+	profile.InterimUpdateInterval = utils.IntPtrValue(m.InterimUpdateInterval.ValueInt32Pointer())
+}
+
+func newRADIUSProfileResourceModel(profile *unifi.RADIUSProfile, site string, model RADIUSProfileResourceModel) RADIUSProfileResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(profile.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(profile.SiteID)
+
+	// Configurable Values
+	model.Name = types.StringPointerValue(profile.Name)
+	model.VLANEnabled = types.BoolValue(profile.VLANEnabled)
+	model.WLANVLANMode = types.StringPointerValue(profile.VLANWLANMode)
+
+	var authServers []RADIUSProfileServerResourceModel
+	if profile.AuthServers != nil {
+		for i, server := range *profile.AuthServers {
+			authServers = append(authServers, newRADIUSProfileServerResourceModel(server.IP, server.Port, server.XSecret, indexServer(model.AuthServers, i)))
+		}
+	}
+	model.AuthServers = authServers
+
+	var accountingServers []RADIUSProfileServerResourceModel
+	if profile.AccountingEnabled && profile.AcctServers != nil && len(*profile.AcctServers) > 0 {
+		for i, server := range *profile.AcctServers {
+			accountingServers = append(accountingServers, newRADIUSProfileServerResourceModel(server.IP, server.Port, server.XSecret, indexServer(model.AccountingServers, i)))
+		}
+	}
+	model.AccountingServers = accountingServers
+
+	model.InterimUpdateInterval = types.Int32Null()
+	if profile.InterimUpdateEnabled {
+		model.InterimUpdateInterval = types.Int32PointerValue(utils.Int32PtrValue(profile.InterimUpdateInterval))
+	}
+
+	return model
+}
+
+type RADIUSProfileServerResourceModel struct {
+	IP     iptypes.IPv4Address `tfsdk:"ip"`
+	Port   types.Int32         `tfsdk:"port"`
+	Secret types.String        `tfsdk:"secret"`
+}
+
+func (m *RADIUSProfileServerResourceModel) schema(defaultPort int32) schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 address of the server.",
+				CustomType:          iptypes.IPv4AddressType{},
+				Required:            true,
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: fmt.Sprintf("Default: `%d`", defaultPort),
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(defaultPort),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The secret shared with the server.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func newRADIUSProfileServerResourceModel(ip *string, port *int, secret *string, model RADIUSProfileServerResourceModel) RADIUSProfileServerResourceModel {
+	model.IP = iptypes.NewIPv4AddressPointerValue(ip)
+	model.Port = types.Int32PointerValue(utils.Int32PtrValue(port))
+
+	// The secret isn't always returned, so keep the configured value when it's missing.
+	if secret != nil && *secret != "" || model.Secret.IsNull() {
+		model.Secret = types.StringPointerValue(secret)
+	}
+
+	return model
+}
+
+// indexServer returns the server at index i, or an empty server when there isn't one.
+func indexServer(servers []RADIUSProfileServerResourceModel, i int) RADIUSProfileServerResourceModel {
+	if i < len(servers) {
+		return servers[i]
+	}
+
+	return RADIUSProfileServerResourceModel{}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccRADIUSProfileResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRADIUSProfileConfig(`interim_update_interval = 3600`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccRADIUSProfileConfig(`wlan_vlan_mode = "always"`),
+				ExpectError: regexp.MustCompile(`Attribute wlan_vlan_mode value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccRADIUSProfileResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRADIUSProfileConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "name", "Test Profile"),
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "auth_servers.#", "1"),
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "auth_servers.0.ip", "192.168.1.10"),
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "auth_servers.0.port", "1812"),
+					resource.TestCheckNoResourceAttr("unifi_radius_profile.test", "accounting_servers"),
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "vlan_enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "wlan_vlan_mode", "disabled"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_radius_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRADIUSProfileConfig(`
+  accounting_servers = [
+    {
+      ip     = "192.168.1.10"
+      secret = "accounting-secret"
+    },
+  ]
+  interim_update_interval = 3600
+  vlan_enabled            = true
+  wlan_vlan_mode          = "optional"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "accounting_servers.#", "1"),
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "accounting_servers.0.port", "1813"),
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "interim_update_interval", "3600"),
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "vlan_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_radius_profile.test", "wlan_vlan_mode", "optional"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRADIUSProfileConfig(settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_radius_profile" "test" {
+  name = "Test Profile"
+  auth_servers = [
+    {
+      ip     = "192.168.1.10"
+      secret = "auth-secret"
+    },
+  ]
+  %s
+}
+`, settings)
+}
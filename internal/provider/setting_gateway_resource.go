@@ -0,0 +1,620 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"slices"
+	"strings"
+)
+
+const (
+	settingGatewayARPCacheTimeoutCustom       = "custom"
+	settingGatewayARPCacheTimeoutMinDHCPLease = "min-dhcp-lease"
+	settingGatewayARPCacheTimeoutNormal       = "normal"
+
+	settingGatewayMSSClampAuto     = "auto"
+	settingGatewayMSSClampCustom   = "custom"
+	settingGatewayMSSClampDisabled = "disabled"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &SettingGatewayResource{}
+	_ resource.ResourceWithImportState = &SettingGatewayResource{}
+
+	defaultSettingGatewayResourceModel = SettingGatewayResourceModel{}
+)
+
+func NewSettingGatewayResource() resource.Resource {
+	return &SettingGatewayResource{}
+}
+
+// SettingGatewayResource defines the resource implementation.
+type SettingGatewayResource struct {
+	client *unifiClient
+}
+
+func (r *SettingGatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setting_gateway"
+}
+
+func (r *SettingGatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultSettingGatewayResourceModel.schema()
+}
+
+func (r *SettingGatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SettingGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SettingGatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// The settings always exist for a site, so creating the resource takes them over.
+	setting, networks, diags := r.client.updateSettingGateway(ctx, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags = newSettingGatewayResourceModel(ctx, setting, networks, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Gateway settings created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SettingGatewayResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	setting, err := r.client.GetSettingUsg(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read gateway settings, got error: %s", err))
+		return
+	}
+
+	networks, err := r.client.listNetworkServices(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read networks, got error: %s", err))
+		return
+	}
+
+	data, diags := newSettingGatewayResourceModel(ctx, setting, networks, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SettingGatewayResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	setting, networks, diags := r.client.updateSettingGateway(ctx, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags = newSettingGatewayResourceModel(ctx, setting, networks, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the settings from the Terraform state. They can't be removed from the controller, and there
+// are no defaults that are safe to reset them to.
+func (r *SettingGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ImportState imports the settings of the site given as the ID.
+func (r *SettingGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("site"), req, resp)
+}
+
+type SettingGatewayResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	ARPCacheBaseReachable         types.Int32  `tfsdk:"arp_cache_base_reachable"`
+	ARPCacheTimeout               types.String `tfsdk:"arp_cache_timeout"`
+	DHCPRelayServers              types.List   `tfsdk:"dhcp_relay_servers"`
+	FTPALGEnabled                 types.Bool   `tfsdk:"ftp_alg_enabled"`
+	IGMPProxyDownstreamNetworkIDs types.Set    `tfsdk:"igmp_proxy_downstream_network_ids"`
+	IGMPProxyUpstreamNetworkID    types.String `tfsdk:"igmp_proxy_upstream_network_id"`
+	MDNSEnabled                   types.Bool   `tfsdk:"mdns_enabled"`
+	MDNSNetworkIDs                types.Set    `tfsdk:"mdns_network_ids"`
+	MSSClamp                      types.String `tfsdk:"mss_clamp"`
+	MSSClampMSS                   types.Int32  `tfsdk:"mss_clamp_mss"`
+	OffloadAccounting             types.Bool   `tfsdk:"offload_accounting"`
+	OffloadL2Blocking             types.Bool   `tfsdk:"offload_l2_blocking"`
+	OffloadScheduler              types.Bool   `tfsdk:"offload_scheduler"`
+	PPTPALGEnabled                types.Bool   `tfsdk:"pptp_alg_enabled"`
+	SIPALGEnabled                 types.Bool   `tfsdk:"sip_alg_enabled"`
+	Site                          types.String `tfsdk:"site"`
+	TFTPALGEnabled                types.Bool   `tfsdk:"tftp_alg_enabled"`
+	UPnPEnabled                   types.Bool   `tfsdk:"upnp_enabled"`
+	UPnPNATPMPEnabled             types.Bool   `tfsdk:"upnp_nat_pmp_enabled"`
+	UPnPNetworkIDs                types.Set    `tfsdk:"upnp_network_ids"`
+	UPnPSecureMode                types.Bool   `tfsdk:"upnp_secure_mode"`
+	UPnPWANInterface              types.String `tfsdk:"upnp_wan_interface"`
+}
+
+func (m *SettingGatewayResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The gateway service settings of a site, such as UPnP, mDNS, IGMP proxy and DHCP relay. " +
+			"There is a single set of settings per site, so only one of these resources should exist for each site. " +
+			"Destroying the resource leaves the settings as they are.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi setting identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"arp_cache_base_reachable": schema.Int32Attribute{
+				MarkdownDescription: "The base time, in seconds, ARP cache entries are kept for when " +
+					"`arp_cache_timeout` is `custom`.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 99999),
+				},
+			},
+			"arp_cache_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long ARP cache entries are kept for. Default: `normal`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(settingGatewayARPCacheTimeoutNormal),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						settingGatewayARPCacheTimeoutCustom,
+						settingGatewayARPCacheTimeoutMinDHCPLease,
+						settingGatewayARPCacheTimeoutNormal,
+					),
+					customvalidator.StringValueWithPaths(settingGatewayARPCacheTimeoutCustom, path.MatchRoot("arp_cache_base_reachable")),
+					customvalidator.StringValueConflictsWithPaths(settingGatewayARPCacheTimeoutMinDHCPLease, path.MatchRoot("arp_cache_base_reachable")),
+					customvalidator.StringValueConflictsWithPaths(settingGatewayARPCacheTimeoutNormal, path.MatchRoot("arp_cache_base_reachable")),
+				},
+			},
+			"dhcp_relay_servers": schema.ListAttribute{
+				MarkdownDescription: "The DHCP servers that requests are relayed to, for networks with DHCP relay " +
+					"enabled.",
+				ElementType: iptypes.IPv4AddressType{},
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 5),
+				},
+			},
+			"ftp_alg_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Default: `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"igmp_proxy_downstream_network_ids": schema.SetAttribute{
+				MarkdownDescription: "The networks multicast traffic from the upstream network is proxied to.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AlsoRequires(path.MatchRoot("igmp_proxy_upstream_network_id")),
+				},
+			},
+			"igmp_proxy_upstream_network_id": schema.StringAttribute{
+				MarkdownDescription: "The network, usually a WAN, multicast traffic is proxied from.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("igmp_proxy_downstream_network_ids")),
+				},
+			},
+			"mdns_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, mDNS is reflected between the `mdns_network_ids`. Default: `false`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"mdns_network_ids": schema.SetAttribute{
+				MarkdownDescription: "The networks mDNS is reflected between.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"mss_clamp": schema.StringAttribute{
+				MarkdownDescription: "How the TCP MSS is clamped. Default: `auto`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(settingGatewayMSSClampAuto),
+				Validators: []validator.String{
+					stringvalidator.OneOf(settingGatewayMSSClampAuto, settingGatewayMSSClampCustom, settingGatewayMSSClampDisabled),
+					customvalidator.StringValueWithPaths(settingGatewayMSSClampCustom, path.MatchRoot("mss_clamp_mss")),
+					customvalidator.StringValueConflictsWithPaths(settingGatewayMSSClampAuto, path.MatchRoot("mss_clamp_mss")),
+					customvalidator.StringValueConflictsWithPaths(settingGatewayMSSClampDisabled, path.MatchRoot("mss_clamp_mss")),
+				},
+			},
+			"mss_clamp_mss": schema.Int32Attribute{
+				MarkdownDescription: "The MSS to clamp to when `mss_clamp` is `custom`.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(100, 9999),
+				},
+			},
+			"offload_accounting": schema.BoolAttribute{
+				MarkdownDescription: "When true, hardware offload is used for traffic accounting. When not set the " +
+					"controller's current value is kept.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"offload_l2_blocking": schema.BoolAttribute{
+				MarkdownDescription: "When true, hardware offload is used for layer 2 blocking. When not set the " +
+					"controller's current value is kept.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"offload_scheduler": schema.BoolAttribute{
+				MarkdownDescription: "When true, hardware offload is used for the traffic scheduler. When not set the " +
+					"controller's current value is kept.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pptp_alg_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Default: `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"sip_alg_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Default: `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the settings belong to. Setting this overrides the default site set in " +
+					"the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tftp_alg_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Default: `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"upnp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, clients on the `upnp_network_ids` can open ports with UPnP. Default: " +
+					"`false`",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"upnp_nat_pmp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, NAT-PMP is also enabled. Default: `false`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"upnp_network_ids": schema.SetAttribute{
+				MarkdownDescription: "The networks UPnP is available to.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"upnp_secure_mode": schema.BoolAttribute{
+				MarkdownDescription: "When true, clients can only open ports to themselves. Default: `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"upnp_wan_interface": schema.StringAttribute{
+				MarkdownDescription: "The WAN interface ports are opened on. Default: `WAN`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("WAN"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("WAN", "WAN2"),
+				},
+			},
+		},
+	}
+}
+
+func (m *SettingGatewayResourceModel) toUnifiSettingUsg(ctx context.Context, setting *unifi.SettingUsg) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	setting.ArpCacheTimeout = m.ARPCacheTimeout.ValueStringPointer()
+	if m.ARPCacheTimeout.ValueString() == settingGatewayARPCacheTimeoutCustom {
+		setting.ArpCacheBaseReachable = utils.IntPtrValue(m.ARPCacheBaseReachable.ValueInt32Pointer())
+	}
+
+	var relayServers []string
+	diags.Append(utils.ListValueStrings(ctx, m.DHCPRelayServers, &relayServers)...)
+	setting.DHCPRelayServer1 = utils.IndexString(relayServers, 0)
+	setting.DHCPRelayServer2 = utils.IndexString(relayServers, 1)
+	setting.DHCPRelayServer3 = utils.IndexString(relayServers, 2)
+	setting.DHCPRelayServer4 = utils.IndexString(relayServers, 3)
+	setting.DHCPRelayServer5 = utils.IndexString(relayServers, 4)
+
+	setting.FtpModule = m.FTPALGEnabled.ValueBool()
+	setting.PptpModule = m.PPTPALGEnabled.ValueBool()
+	setting.SipModule = m.SIPALGEnabled.ValueBool()
+	setting.TFTPModule = m.TFTPALGEnabled.ValueBool()
+
+	setting.MdnsEnabled = m.MDNSEnabled.ValueBool()
+
+	setting.MssClamp = m.MSSClamp.ValueStringPointer()
+	if m.MSSClamp.ValueString() == settingGatewayMSSClampCustom {
+		setting.MssClampMss = utils.IntPtrValue(m.MSSClampMSS.ValueInt32Pointer())
+	}
+
+	if !m.OffloadAccounting.IsUnknown() && !m.OffloadAccounting.IsNull() {
+		setting.OffloadAccounting = m.OffloadAccounting.ValueBool()
+	}
+
+	if !m.OffloadL2Blocking.IsUnknown() && !m.OffloadL2Blocking.IsNull() {
+		setting.OffloadL2Blocking = m.OffloadL2Blocking.ValueBool()
+	}
+
+	if !m.OffloadScheduler.IsUnknown() && !m.OffloadScheduler.IsNull() {
+		setting.OffloadSch = m.OffloadScheduler.ValueBool()
+	}
+
+	setting.UpnpEnabled = m.UPnPEnabled.ValueBool()
+	setting.UpnpNATPmpEnabled = m.UPnPNATPMPEnabled.ValueBool()
+	setting.UpnpSecureMode = m.UPnPSecureMode.ValueBool()
+	setting.UpnpWANInterface = m.UPnPWANInterface.ValueStringPointer()
+
+	return diags
+}
+
+// toNetworkServices sets the gateway service flags of each network, returning the networks that have changed.
+func (m *SettingGatewayResourceModel) toNetworkServices(ctx context.Context, networks []networkServices) ([]networkServices, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var downstreamIDs, mdnsIDs, upnpIDs []string
+	diags.Append(utils.SetValueStrings(ctx, m.IGMPProxyDownstreamNetworkIDs, &downstreamIDs)...)
+	diags.Append(utils.SetValueStrings(ctx, m.MDNSNetworkIDs, &mdnsIDs)...)
+	diags.Append(utils.SetValueStrings(ctx, m.UPnPNetworkIDs, &upnpIDs)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	upstreamID := m.IGMPProxyUpstreamNetworkID.ValueString()
+
+	known := make(map[string]bool, len(networks))
+	var changed []networkServices
+	for _, network := range networks {
+		id := utils.StringValue(network.ID)
+		known[id] = true
+
+		want := network
+		want.IGMPProxyDownstream = slices.Contains(downstreamIDs, id)
+		want.IGMPProxyUpstream = id == upstreamID
+		want.MDNSEnabled = slices.Contains(mdnsIDs, id)
+		want.UPnPLANEnabled = slices.Contains(upnpIDs, id)
+
+		if want != network {
+			changed = append(changed, want)
+		}
+	}
+
+	for name, ids := range map[string][]string{
+		"igmp_proxy_downstream_network_ids": downstreamIDs,
+		"igmp_proxy_upstream_network_id":    {upstreamID},
+		"mdns_network_ids":                  mdnsIDs,
+		"upnp_network_ids":                  upnpIDs,
+	} {
+		var missing []string
+		for _, id := range ids {
+			if id != "" && !known[id] {
+				missing = append(missing, id)
+			}
+		}
+
+		if len(missing) > 0 {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Network Not Found",
+				fmt.Sprintf("The networks %s don't exist in the site.", strings.Join(missing, ", ")),
+			)
+		}
+	}
+
+	return changed, diags
+}
+
+func newSettingGatewayResourceModel(ctx context.Context, setting *unifi.SettingUsg, networks []networkServices, site string, model SettingGatewayResourceModel) (SettingGatewayResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(setting.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(setting.SiteID)
+
+	// Configurable Values
+	model.ARPCacheTimeout = types.StringPointerValue(setting.ArpCacheTimeout)
+	model.ARPCacheBaseReachable = types.Int32Null()
+	if model.ARPCacheTimeout.ValueString() == settingGatewayARPCacheTimeoutCustom {
+		model.ARPCacheBaseReachable = types.Int32PointerValue(utils.Int32PtrValue(setting.ArpCacheBaseReachable))
+	}
+
+	model.DHCPRelayServers = types.ListNull(iptypes.IPv4AddressType{})
+	if setting.DHCPRelayServer1 != "" {
+		var d diag.Diagnostics
+		model.DHCPRelayServers, d = utils.AddressListValue(ctx, setting.DHCPRelayServer1, setting.DHCPRelayServer2,
+			setting.DHCPRelayServer3, setting.DHCPRelayServer4, setting.DHCPRelayServer5)
+		diags.Append(d...)
+	}
+
+	model.FTPALGEnabled = types.BoolValue(setting.FtpModule)
+	model.PPTPALGEnabled = types.BoolValue(setting.PptpModule)
+	model.SIPALGEnabled = types.BoolValue(setting.SipModule)
+	model.TFTPALGEnabled = types.BoolValue(setting.TFTPModule)
+
+	model.MDNSEnabled = types.BoolValue(setting.MdnsEnabled)
+
+	model.MSSClamp = types.StringPointerValue(setting.MssClamp)
+	model.MSSClampMSS = types.Int32Null()
+	if model.MSSClamp.ValueString() == settingGatewayMSSClampCustom {
+		model.MSSClampMSS = types.Int32PointerValue(utils.Int32PtrValue(setting.MssClampMss))
+	}
+
+	model.OffloadAccounting = types.BoolValue(setting.OffloadAccounting)
+	model.OffloadL2Blocking = types.BoolValue(setting.OffloadL2Blocking)
+	model.OffloadScheduler = types.BoolValue(setting.OffloadSch)
+
+	model.UPnPEnabled = types.BoolValue(setting.UpnpEnabled)
+	model.UPnPNATPMPEnabled = types.BoolValue(setting.UpnpNATPmpEnabled)
+	model.UPnPSecureMode = types.BoolValue(setting.UpnpSecureMode)
+	model.UPnPWANInterface = types.StringPointerValue(setting.UpnpWANInterface)
+
+	var downstreamIDs, mdnsIDs, upnpIDs []string
+	model.IGMPProxyUpstreamNetworkID = types.StringNull()
+	for _, network := range networks {
+		id := utils.StringValue(network.ID)
+		if network.IGMPProxyDownstream {
+			downstreamIDs = append(downstreamIDs, id)
+		}
+
+		if network.IGMPProxyUpstream {
+			model.IGMPProxyUpstreamNetworkID = types.StringValue(id)
+		}
+
+		if network.MDNSEnabled {
+			mdnsIDs = append(mdnsIDs, id)
+		}
+
+		if network.UPnPLANEnabled {
+			upnpIDs = append(upnpIDs, id)
+		}
+	}
+
+	var d diag.Diagnostics
+	model.IGMPProxyDownstreamNetworkIDs, d = utils.StringSetValue(ctx, downstreamIDs)
+	diags.Append(d...)
+	model.MDNSNetworkIDs, d = utils.StringSetValue(ctx, mdnsIDs)
+	diags.Append(d...)
+	model.UPnPNetworkIDs, d = utils.StringSetValue(ctx, upnpIDs)
+	diags.Append(d...)
+
+	return model, diags
+}
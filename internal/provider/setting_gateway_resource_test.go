@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccSettingGatewayResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSettingGatewayConfig(`mss_clamp = "custom"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccSettingGatewayConfig(`
+  arp_cache_timeout        = "normal"
+  arp_cache_base_reachable = 60
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccSettingGatewayConfig(`igmp_proxy_downstream_network_ids = [unifi_network.test.id]`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccSettingGatewayConfig(`dhcp_relay_servers = ["10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"]`),
+				ExpectError: regexp.MustCompile(`Attribute dhcp_relay_servers list must contain at least 1 elements and at`),
+			},
+		},
+	})
+}
+
+func TestAccSettingGatewayResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSettingGatewayConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "upnp_enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "mdns_enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "mss_clamp", "auto"),
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "sip_alg_enabled", "true"),
+					resource.TestCheckNoResourceAttr("unifi_setting_gateway.test", "upnp_network_ids"),
+					resource.TestCheckNoResourceAttr("unifi_setting_gateway.test", "dhcp_relay_servers"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "unifi_setting_gateway.test",
+				ImportState:                          true,
+				ImportStateId:                        "default",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "site",
+			},
+			// Update and Read testing
+			{
+				Config: testAccSettingGatewayConfig(`
+  upnp_enabled     = true
+  upnp_network_ids = [unifi_network.test.id]
+  mdns_enabled     = true
+  mdns_network_ids = [unifi_network.test.id]
+
+  dhcp_relay_servers = ["10.0.0.1", "10.0.0.2"]
+
+  sip_alg_enabled = false
+  mss_clamp       = "custom"
+  mss_clamp_mss   = 1452
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "upnp_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "upnp_network_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("unifi_setting_gateway.test", "upnp_network_ids.0", "unifi_network.test", "id"),
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "mdns_network_ids.#", "1"),
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "dhcp_relay_servers.#", "2"),
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "sip_alg_enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_setting_gateway.test", "mss_clamp_mss", "1452"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSettingGatewayConfig(settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_network" "test" {
+  name    = "Test Network"
+  subnet  = "10.0.50.1/24"
+  vlan_id = 50
+}
+
+resource "unifi_setting_gateway" "test" {
+  %s
+}
+`, settings)
+}
@@ -0,0 +1,447 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+	"strings"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &SettingMgmtResource{}
+	_ resource.ResourceWithImportState = &SettingMgmtResource{}
+
+	defaultSettingMgmtResourceModel       = SettingMgmtResourceModel{}
+	defaultSettingMgmtSSHKeyResourceModel = SettingMgmtSSHKeyResourceModel{}
+
+	// sshPublicKeyRegexp matches a public key in the authorized_keys format, i.e. `<type> <base64 key> [comment]`.
+	sshPublicKeyRegexp = regexp.MustCompile(`^(ssh-rsa|ssh-dss|ssh-ed25519|ecdsa-sha2-nistp(256|384|521)|sk-ssh-ed25519@openssh\.com|sk-ecdsa-sha2-nistp256@openssh\.com) [A-Za-z0-9+/]+={0,3}( .+)?$`)
+)
+
+func NewSettingMgmtResource() resource.Resource {
+	return &SettingMgmtResource{}
+}
+
+// SettingMgmtResource defines the resource implementation.
+type SettingMgmtResource struct {
+	client *unifiClient
+}
+
+func (r *SettingMgmtResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setting_mgmt"
+}
+
+func (r *SettingMgmtResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultSettingMgmtResourceModel.schema()
+}
+
+func (r *SettingMgmtResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SettingMgmtResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SettingMgmtResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// The settings always exist for a site, so creating the resource takes them over.
+	setting, err := r.client.GetSettingMgmt(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read management settings, got error: %s", err))
+		return
+	}
+
+	data.toUnifiSettingMgmt(setting)
+
+	setting, err = r.client.UpdateSettingMgmt(ctx, site, setting)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update management settings, got error: %s", err))
+		return
+	}
+
+	data = newSettingMgmtResourceModel(setting, site, data)
+
+	tflog.Trace(ctx, "Management settings created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingMgmtResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SettingMgmtResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	setting, err := r.client.GetSettingMgmt(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read management settings, got error: %s", err))
+		return
+	}
+
+	data = newSettingMgmtResourceModel(setting, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingMgmtResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SettingMgmtResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	setting, err := r.client.GetSettingMgmt(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read management settings, got error: %s", err))
+		return
+	}
+
+	data.toUnifiSettingMgmt(setting)
+
+	setting, err = r.client.UpdateSettingMgmt(ctx, site, setting)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update management settings, got error: %s", err))
+		return
+	}
+
+	data = newSettingMgmtResourceModel(setting, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the settings from the Terraform state. They can't be removed from the controller, and there
+// are no defaults that are safe to reset them to.
+func (r *SettingMgmtResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ImportState imports the settings of the site given as the ID.
+func (r *SettingMgmtResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("site"), req, resp)
+}
+
+type SettingMgmtResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	AlertEnabled           types.Bool                       `tfsdk:"alert_enabled"`
+	AutoUpgrade            types.Bool                       `tfsdk:"auto_upgrade"`
+	AutoUpgradeHour        types.Int32                      `tfsdk:"auto_upgrade_hour"`
+	DirectConnectEnabled   types.Bool                       `tfsdk:"direct_connect_enabled"`
+	LEDEnabled             types.Bool                       `tfsdk:"led_enabled"`
+	Site                   types.String                     `tfsdk:"site"`
+	SSHAuthPasswordEnabled types.Bool                       `tfsdk:"ssh_auth_password_enabled"`
+	SSHEnabled             types.Bool                       `tfsdk:"ssh_enabled"`
+	SSHKeys                []SettingMgmtSSHKeyResourceModel `tfsdk:"ssh_keys"`
+	SSHPassword            types.String                     `tfsdk:"ssh_password"`
+	SSHUsername            types.String                     `tfsdk:"ssh_username"`
+}
+
+func (m *SettingMgmtResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The device management settings of a site, such as device SSH access and automatic " +
+			"upgrades. There is a single set of settings per site, so only one of these resources should exist for " +
+			"each site. Destroying the resource leaves the settings as they are.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi setting identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"alert_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, alerts are raised for device events. Default: `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"auto_upgrade": schema.BoolAttribute{
+				MarkdownDescription: "When true, devices are upgraded to the latest firmware automatically. Default: " +
+					"`false`",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"auto_upgrade_hour": schema.Int32Attribute{
+				MarkdownDescription: "The hour of the day, in the site's timezone, that automatic upgrades happen at. " +
+					"When not set the controller's current hour is kept.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(0, 23),
+				},
+			},
+			"direct_connect_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, UniFi Direct lets the access points be managed directly by " +
+					"connecting to them. Default: `false`",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"led_enabled": schema.BoolAttribute{
+				MarkdownDescription: "The default LED state of the devices, used unless a device overrides it. " +
+					"Default: `true`",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the settings belong to. Setting this overrides the default site set in " +
+					"the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_auth_password_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, the device SSH password can be used to log in. When false only the " +
+					"`ssh_keys` can be. Default: `true`",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"ssh_enabled": schema.BoolAttribute{
+				MarkdownDescription: "When true, SSH is enabled on the devices. Default: `false`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ssh_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The public keys that can be used to log in to the devices over SSH. When set " +
+					"these replace any keys already on the controller, including with an empty list. When not set the " +
+					"keys on the controller are left as they are.",
+				NestedObject: defaultSettingMgmtSSHKeyResourceModel.schema(),
+				Optional:     true,
+			},
+			"ssh_password": schema.StringAttribute{
+				MarkdownDescription: "The password used to log in to the devices over SSH. When not set the " +
+					"controller's generated password is kept.",
+				Computed:  true,
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"ssh_username": schema.StringAttribute{
+				MarkdownDescription: "The username used to log in to the devices over SSH. When not set the " +
+					"controller's generated username is kept.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[_A-Za-z0-9][-_.A-Za-z0-9]{0,29}$`),
+						"must start with a letter, number or underscore, and only contain letters, numbers, "+
+							"hyphens, underscores and periods, up to 30 characters",
+					),
+				},
+			},
+		},
+	}
+}
+
+func (m *SettingMgmtResourceModel) toUnifiSettingMgmt(setting *unifi.SettingMgmt) {
+	setting.AlertEnabled = m.AlertEnabled.ValueBool()
+	setting.AutoUpgrade = m.AutoUpgrade.ValueBool()
+	setting.DirectConnectEnabled = m.DirectConnectEnabled.ValueBool()
+	setting.LedEnabled = m.LEDEnabled.ValueBool()
+	setting.XSshAuthPasswordEnabled = m.SSHAuthPasswordEnabled.ValueBool()
+	setting.XSshEnabled = m.SSHEnabled.ValueBool()
+
+	if !m.AutoUpgradeHour.IsUnknown() && !m.AutoUpgradeHour.IsNull() {
+		setting.AutoUpgradeHour = utils.IntPtrValue(m.AutoUpgradeHour.ValueInt32Pointer())
+	}
+
+	if !m.SSHPassword.IsUnknown() && !m.SSHPassword.IsNull() {
+		setting.XSshPassword = m.SSHPassword.ValueStringPointer()
+	}
+
+	if !m.SSHUsername.IsUnknown() && !m.SSHUsername.IsNull() {
+		setting.XSshUsername = m.SSHUsername.ValueStringPointer()
+	}
+
+	// Keys already on the controller are left alone unless ssh_keys is set.
+	if m.SSHKeys == nil {
+		return
+	}
+
+	keys := make([]unifi.SettingMgmtXSshKeys, 0, len(m.SSHKeys))
+	for _, key := range m.SSHKeys {
+		keys = append(keys, key.toUnifiSettingMgmtXSshKeys())
+	}
+
+	setting.XSshKeys = &keys
+}
+
+func newSettingMgmtResourceModel(setting *unifi.SettingMgmt, site string, model SettingMgmtResourceModel) SettingMgmtResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(setting.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(setting.SiteID)
+
+	// Configurable Values
+	model.AlertEnabled = types.BoolValue(setting.AlertEnabled)
+	model.AutoUpgrade = types.BoolValue(setting.AutoUpgrade)
+	model.AutoUpgradeHour = types.Int32PointerValue(utils.Int32PtrValue(setting.AutoUpgradeHour))
+	model.DirectConnectEnabled = types.BoolValue(setting.DirectConnectEnabled)
+	model.LEDEnabled = types.BoolValue(setting.LedEnabled)
+	model.SSHAuthPasswordEnabled = types.BoolValue(setting.XSshAuthPasswordEnabled)
+	model.SSHEnabled = types.BoolValue(setting.XSshEnabled)
+	model.SSHPassword = types.StringPointerValue(setting.XSshPassword)
+	model.SSHUsername = types.StringPointerValue(setting.XSshUsername)
+
+	// Only read the keys back when they're managed, keeping an empty list distinct from an unset one.
+	if model.SSHKeys != nil {
+		model.SSHKeys = []SettingMgmtSSHKeyResourceModel{}
+		if setting.XSshKeys != nil {
+			for _, key := range *setting.XSshKeys {
+				model.SSHKeys = append(model.SSHKeys, newSettingMgmtSSHKeyResourceModel(key))
+			}
+		}
+	}
+
+	return model
+}
+
+type SettingMgmtSSHKeyResourceModel struct {
+	Key  types.String `tfsdk:"key"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (m *SettingMgmtSSHKeyResourceModel) schema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The public key in the `authorized_keys` format, e.g. `ssh-ed25519 AAAA... " +
+					"user@host`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(sshPublicKeyRegexp, "must be a public key in the authorized_keys format"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// toUnifiSettingMgmtXSshKeys splits the key into the type, key and comment the controller stores separately.
+func (m *SettingMgmtSSHKeyResourceModel) toUnifiSettingMgmtXSshKeys() unifi.SettingMgmtXSshKeys {
+	key := unifi.SettingMgmtXSshKeys{
+		Name: m.Name.ValueString(),
+	}
+
+	fields := strings.SplitN(m.Key.ValueString(), " ", 3)
+	key.KeyType = fields[0]
+	if len(fields) > 1 {
+		key.Key = fields[1]
+	}
+
+	if len(fields) > 2 {
+		key.Comment = fields[2]
+	}
+
+	return key
+}
+
+func newSettingMgmtSSHKeyResourceModel(key unifi.SettingMgmtXSshKeys) SettingMgmtSSHKeyResourceModel {
+	value := key.KeyType + " " + key.Key
+	if key.Comment != "" {
+		value += " " + key.Comment
+	}
+
+	return SettingMgmtSSHKeyResourceModel{
+		Key:  types.StringValue(value),
+		Name: types.StringValue(key.Name),
+	}
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccSettingMgmtResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSettingMgmtConfig(`auto_upgrade_hour = 24`),
+				ExpectError: regexp.MustCompile(`Attribute auto_upgrade_hour value must be between 0 and 23`),
+			},
+			{
+				Config: testAccSettingMgmtConfig(`
+  ssh_keys = [
+    {
+      name = "test"
+      key  = "not-a-key"
+    },
+  ]
+`),
+				ExpectError: regexp.MustCompile(`must be a public key in the authorized_keys format`),
+			},
+		},
+	})
+}
+
+func TestAccSettingMgmtResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSettingMgmtConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "alert_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "auto_upgrade", "false"),
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "led_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "ssh_enabled", "false"),
+					resource.TestCheckResourceAttrSet("unifi_setting_mgmt.test", "ssh_username"),
+					resource.TestCheckNoResourceAttr("unifi_setting_mgmt.test", "ssh_keys"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "unifi_setting_mgmt.test",
+				ImportState:                          true,
+				ImportStateId:                        "default",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "site",
+			},
+			// Update and Read testing
+			{
+				Config: testAccSettingMgmtConfig(`
+  auto_upgrade      = true
+  auto_upgrade_hour = 3
+  led_enabled       = false
+  ssh_enabled       = true
+  ssh_username      = "admin"
+  ssh_password      = "test-password"
+  ssh_keys = [
+    {
+      name = "test"
+      key  = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEZ8mWm0hU4yZ7n0yB9lHbnE6nC1qZo5cP0P8X8ZbQq4 test@example.com"
+    },
+  ]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "auto_upgrade", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "auto_upgrade_hour", "3"),
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "led_enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "ssh_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "ssh_username", "admin"),
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "ssh_keys.#", "1"),
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "ssh_keys.0.name", "test"),
+				),
+			},
+			// Removing all the keys
+			{
+				Config: testAccSettingMgmtConfig(`
+  ssh_keys = []
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_mgmt.test", "ssh_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSettingMgmtConfig(settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_setting_mgmt" "test" {
+  %s
+}
+`, settings)
+}
@@ -0,0 +1,317 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &SettingRADIUSResource{}
+	_ resource.ResourceWithImportState = &SettingRADIUSResource{}
+
+	defaultSettingRADIUSResourceModel = SettingRADIUSResourceModel{}
+)
+
+func NewSettingRADIUSResource() resource.Resource {
+	return &SettingRADIUSResource{}
+}
+
+// SettingRADIUSResource defines the resource implementation.
+type SettingRADIUSResource struct {
+	client *unifiClient
+}
+
+func (r *SettingRADIUSResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setting_radius"
+}
+
+func (r *SettingRADIUSResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultSettingRADIUSResourceModel.schema()
+}
+
+func (r *SettingRADIUSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SettingRADIUSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SettingRADIUSResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// The settings always exist for a site, so creating the resource takes them over.
+	setting, err := r.client.GetSettingRadius(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RADIUS settings, got error: %s", err))
+		return
+	}
+
+	data.toUnifiSettingRadius(setting)
+
+	setting, err = r.client.UpdateSettingRadius(ctx, site, setting)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RADIUS settings, got error: %s", err))
+		return
+	}
+
+	data = newSettingRADIUSResourceModel(setting, site, data)
+
+	tflog.Trace(ctx, "RADIUS settings created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingRADIUSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SettingRADIUSResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	setting, err := r.client.GetSettingRadius(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RADIUS settings, got error: %s", err))
+		return
+	}
+
+	data = newSettingRADIUSResourceModel(setting, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingRADIUSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SettingRADIUSResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	setting, err := r.client.GetSettingRadius(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RADIUS settings, got error: %s", err))
+		return
+	}
+
+	data.toUnifiSettingRadius(setting)
+
+	setting, err = r.client.UpdateSettingRadius(ctx, site, setting)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RADIUS settings, got error: %s", err))
+		return
+	}
+
+	data = newSettingRADIUSResourceModel(setting, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the settings from the Terraform state. They can't be removed from the controller, and turning
+// the server off would break any WLAN or port still using it.
+func (r *SettingRADIUSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ImportState imports the settings of the site given as the ID.
+func (r *SettingRADIUSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("site"), req, resp)
+}
+
+type SettingRADIUSResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	AccountingEnabled     types.Bool   `tfsdk:"accounting_enabled"`
+	AccountingPort        types.Int32  `tfsdk:"accounting_port"`
+	AuthPort              types.Int32  `tfsdk:"auth_port"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	InterimUpdateInterval types.Int32  `tfsdk:"interim_update_interval"`
+	Secret                types.String `tfsdk:"secret"`
+	Site                  types.String `tfsdk:"site"`
+	TunneledReply         types.Bool   `tfsdk:"tunneled_reply"`
+}
+
+func (m *SettingRADIUSResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "The settings of the built in RADIUS server of a site. There is a single set of settings " +
+			"per site, so only one of these resources should exist for each site. Destroying the resource leaves the " +
+			"settings as they are.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi setting identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"accounting_enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"accounting_port": schema.Int32Attribute{
+				MarkdownDescription: "Default: `1813`",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(1813),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"auth_port": schema.Int32Attribute{
+				MarkdownDescription: "Default: `1812`",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(1812),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"interim_update_interval": schema.Int32Attribute{
+				MarkdownDescription: "How often, in seconds, interim accounting updates are requested. Default: `3600`",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(3600),
+				Validators: []validator.Int32{
+					int32validator.Between(60, 86400),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The secret shared with the access points and switches using the server.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 48),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^\\"' ]+$`), "must not contain quotes, backslashes or spaces"),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the settings belong to. Setting this overrides the default site set in " +
+					"the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tunneled_reply": schema.BoolAttribute{
+				MarkdownDescription: "When true, replies are tunneled through the EAP session, as some clients, e.g. " +
+					"Windows, require.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (m *SettingRADIUSResourceModel) toUnifiSettingRadius(setting *unifi.SettingRadius) {
+	setting.AccountingEnabled = m.AccountingEnabled.ValueBool()
+	setting.AcctPort = utils.IntPtrValue(m.AccountingPort.ValueInt32Pointer())
+	setting.AuthPort = utils.IntPtrValue(m.AuthPort.ValueInt32Pointer())
+	setting.Enabled = m.Enabled.ValueBool()
+	setting.InterimUpdateInterval = utils.IntPtrValue(m.InterimUpdateInterval.ValueInt32Pointer())
+	setting.TunneledReply = m.TunneledReply.ValueBool()
+	setting.XSecret = m.Secret.ValueStringPointer()
+}
+
+func newSettingRADIUSResourceModel(setting *unifi.SettingRadius, site string, model SettingRADIUSResourceModel) SettingRADIUSResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(setting.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(setting.SiteID)
+
+	// Configurable Values
+	model.AccountingEnabled = types.BoolValue(setting.AccountingEnabled)
+	model.AccountingPort = types.Int32PointerValue(utils.Int32PtrValue(setting.AcctPort))
+	model.AuthPort = types.Int32PointerValue(utils.Int32PtrValue(setting.AuthPort))
+	model.Enabled = types.BoolValue(setting.Enabled)
+	model.InterimUpdateInterval = types.Int32PointerValue(utils.Int32PtrValue(setting.InterimUpdateInterval))
+
+	// The secret isn't always returned, so keep the configured value when it's missing.
+	if setting.XSecret != nil && *setting.XSecret != "" || model.Secret.IsNull() {
+		model.Secret = types.StringPointerValue(setting.XSecret)
+	}
+
+	model.TunneledReply = types.BoolValue(setting.TunneledReply)
+
+	return model
+}
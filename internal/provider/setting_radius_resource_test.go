@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccSettingRADIUSResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSettingRADIUSConfig(`secret = "has space"`),
+				ExpectError: regexp.MustCompile(`must not contain quotes, backslashes or spaces`),
+			},
+			{
+				Config: testAccSettingRADIUSConfig(`
+  secret                  = "test-secret"
+  interim_update_interval = 10
+`),
+				ExpectError: regexp.MustCompile(`Attribute interim_update_interval value must be between 60 and 86400`),
+			},
+		},
+	})
+}
+
+func TestAccSettingRADIUSResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSettingRADIUSConfig(`secret = "test-secret"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_radius.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_radius.test", "auth_port", "1812"),
+					resource.TestCheckResourceAttr("unifi_setting_radius.test", "accounting_port", "1813"),
+					resource.TestCheckResourceAttr("unifi_setting_radius.test", "accounting_enabled", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "unifi_setting_radius.test",
+				ImportState:                          true,
+				ImportStateId:                        "default",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "site",
+			},
+			// Update and Read testing
+			{
+				Config: testAccSettingRADIUSConfig(`
+  secret             = "test-secret"
+  accounting_enabled = true
+  auth_port          = 11812
+  accounting_port    = 11813
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_setting_radius.test", "accounting_enabled", "true"),
+					resource.TestCheckResourceAttr("unifi_setting_radius.test", "auth_port", "11812"),
+					resource.TestCheckResourceAttr("unifi_setting_radius.test", "accounting_port", "11813"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSettingRADIUSConfig(settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_setting_radius" "test" {
+  %s
+}
+`, settings)
+}
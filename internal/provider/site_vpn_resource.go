@@ -0,0 +1,778 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+	"strconv"
+)
+
+const (
+	networkPurposeSiteVPN = "site-vpn"
+
+	siteVPNTypeAuto    = "auto"
+	siteVPNTypeIPsec   = "ipsec"
+	siteVPNTypeOpenVPN = "openvpn"
+
+	vpnTypeAuto    = "auto"
+	vpnTypeIPsec   = "ipsec-vpn"
+	vpnTypeOpenVPN = "openvpn-vpn"
+
+	ipsecProfileCustomized = "customized"
+
+	openVPNModeSiteToSite = "site-to-site"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &SiteVPNResource{}
+	_ resource.ResourceWithImportState = &SiteVPNResource{}
+
+	defaultSiteVPNAutoResourceModel    = SiteVPNAutoResourceModel{}
+	defaultSiteVPNIPsecResourceModel   = SiteVPNIPsecResourceModel{}
+	defaultSiteVPNOpenVPNResourceModel = SiteVPNOpenVPNResourceModel{}
+	defaultSiteVPNResourceModel        = SiteVPNResourceModel{}
+
+	// siteVPNTypes maps the type of the resource to the VPN type used by the controller.
+	siteVPNTypes = map[string]string{
+		siteVPNTypeAuto:    vpnTypeAuto,
+		siteVPNTypeIPsec:   vpnTypeIPsec,
+		siteVPNTypeOpenVPN: vpnTypeOpenVPN,
+	}
+
+	ipsecDHGroups    = []int32{1, 2, 5, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32}
+	ipsecEncryptions = []string{"aes128", "aes192", "aes256", "3des"}
+	ipsecHashes      = []string{"sha1", "md5", "sha256", "sha384", "sha512"}
+)
+
+func NewSiteVPNResource() resource.Resource {
+	return &SiteVPNResource{}
+}
+
+// SiteVPNResource defines the resource implementation.
+type SiteVPNResource struct {
+	client *unifiClient
+}
+
+func (r *SiteVPNResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_vpn"
+}
+
+func (r *SiteVPNResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultSiteVPNResourceModel.schema()
+}
+
+func (r *SiteVPNResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SiteVPNResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SiteVPNResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	sites, err := r.client.ListSites(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list sites, got error: %s", err))
+		return
+	}
+
+	network := &unifi.Network{
+		Enabled: true,
+		Purpose: utils.StringPtr(networkPurposeSiteVPN),
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network, sites)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err = r.client.CreateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create site VPN, got error: %s", err))
+		return
+	}
+
+	data, diags := newSiteVPNResourceModel(ctx, network, sites, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "Site VPN created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SiteVPNResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SiteVPNResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read site VPN, got error: %s", err))
+		return
+	}
+
+	if network.Purpose == nil || *network.Purpose != networkPurposeSiteVPN {
+		resp.Diagnostics.AddError(
+			"Invalid Network Purpose",
+			fmt.Sprintf("Network %s is not a site-to-site VPN.", data.ID.ValueString()),
+		)
+
+		return
+	}
+
+	sites, err := r.client.ListSites(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list sites, got error: %s", err))
+		return
+	}
+
+	data, diags := newSiteVPNResourceModel(ctx, network, sites, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SiteVPNResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SiteVPNResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	sites, err := r.client.ListSites(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list sites, got error: %s", err))
+		return
+	}
+
+	// Start from the current network so settings that aren't managed by the resource are left untouched.
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read site VPN, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network, sites)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err = r.client.UpdateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update site VPN, got error: %s", err))
+		return
+	}
+
+	data, diags := newSiteVPNResourceModel(ctx, network, sites, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SiteVPNResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SiteVPNResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteNetwork(ctx, site, data.ID.ValueString(), data.Name.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete site VPN, got error: %s", err))
+		return
+	}
+}
+
+func (r *SiteVPNResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type SiteVPNResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	Auto          *SiteVPNAutoResourceModel    `tfsdk:"auto"`
+	Enabled       types.Bool                   `tfsdk:"enabled"`
+	IPsec         *SiteVPNIPsecResourceModel   `tfsdk:"ipsec"`
+	Name          types.String                 `tfsdk:"name"`
+	OpenVPN       *SiteVPNOpenVPNResourceModel `tfsdk:"openvpn"`
+	RemoteSubnets types.Set                    `tfsdk:"remote_subnets"`
+	Site          types.String                 `tfsdk:"site"`
+	Type          types.String                 `tfsdk:"type"`
+}
+
+func (m *SiteVPNResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A site-to-site VPN from the gateway. All the networks of the site are reachable over " +
+			"the VPN, the controller doesn't support limiting the local networks per VPN.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi network identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"auto": defaultSiteVPNAutoResourceModel.schema(),
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"ipsec": defaultSiteVPNIPsecResourceModel.schema(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"openvpn": defaultSiteVPNOpenVPNResourceModel.schema(),
+			"remote_subnets": schema.SetAttribute{
+				MarkdownDescription: "The subnets at the remote end of the VPN. Required when `type` is `ipsec` or " +
+					"`openvpn`, the subnets of the remote site are used for `auto` VPNs.",
+				ElementType: cidrtypes.IPv4PrefixType{},
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the VPN belongs to. Setting this overrides the default site set in the " +
+					"provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of VPN. One of `auto`, `ipsec` or `openvpn`. `auto` VPNs are set up by " +
+					"the controller between two of its sites.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(siteVPNTypeAuto, siteVPNTypeIPsec, siteVPNTypeOpenVPN),
+					customvalidator.StringValueWithPaths(siteVPNTypeAuto, path.MatchRoot("auto")),
+					customvalidator.StringValueWithPaths(siteVPNTypeIPsec, path.MatchRoot("ipsec"), path.MatchRoot("remote_subnets")),
+					customvalidator.StringValueWithPaths(siteVPNTypeOpenVPN, path.MatchRoot("openvpn"), path.MatchRoot("remote_subnets")),
+					customvalidator.StringValueConflictsWithPaths(siteVPNTypeAuto,
+						path.MatchRoot("ipsec"),
+						path.MatchRoot("openvpn"),
+						path.MatchRoot("remote_subnets"),
+					),
+					customvalidator.StringValueConflictsWithPaths(siteVPNTypeIPsec, path.MatchRoot("auto"), path.MatchRoot("openvpn")),
+					customvalidator.StringValueConflictsWithPaths(siteVPNTypeOpenVPN, path.MatchRoot("auto"), path.MatchRoot("ipsec")),
+				},
+			},
+		},
+	}
+}
+
+func (m *SiteVPNResourceModel) toUnifiNetwork(ctx context.Context, network *unifi.Network, sites []unifi.Site) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	network.Enabled = m.Enabled.ValueBool()
+	network.Name = m.Name.ValueStringPointer()
+	network.VPNType = utils.StringPtr(siteVPNTypes[m.Type.ValueString()])
+
+	var remoteSubnets []string
+	diags.Append(utils.SetValueStrings(ctx, m.RemoteSubnets, &remoteSubnets)...)
+	network.RemoteVPNSubnets = &remoteSubnets
+
+	diags.Append(m.Auto.toUnifiNetwork(network, sites)...)
+	m.IPsec.toUnifiNetwork(network)
+	m.OpenVPN.toUnifiNetwork(network)
+
+	return diags
+}
+
+func newSiteVPNResourceModel(ctx context.Context, network *unifi.Network, sites []unifi.Site, site string, model SiteVPNResourceModel) (SiteVPNResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(network.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.Enabled = types.BoolValue(network.Enabled)
+	model.Name = types.StringPointerValue(network.Name)
+
+	model.Type = types.StringNull()
+	for t, vpnType := range siteVPNTypes {
+		if network.VPNType != nil && *network.VPNType == vpnType {
+			model.Type = types.StringValue(t)
+		}
+	}
+
+	var remoteSubnets []string
+	if network.RemoteVPNSubnets != nil && model.Type.ValueString() != siteVPNTypeAuto {
+		remoteSubnets = *network.RemoteVPNSubnets
+	}
+
+	model.RemoteSubnets = types.SetNull(cidrtypes.IPv4PrefixType{})
+	if len(remoteSubnets) > 0 {
+		var d diag.Diagnostics
+		model.RemoteSubnets, d = types.SetValueFrom(ctx, cidrtypes.IPv4PrefixType{}, remoteSubnets)
+		diags.Append(d...)
+	}
+
+	model.Auto = newSiteVPNAutoResourceModel(network, sites, model.Auto)
+	model.IPsec = newSiteVPNIPsecResourceModel(network, model.IPsec)
+	model.OpenVPN = newSiteVPNOpenVPNResourceModel(network, model.OpenVPN)
+
+	return model, diags
+}
+
+type SiteVPNAutoResourceModel struct {
+	RemoteSite types.String `tfsdk:"remote_site"`
+}
+
+func (m *SiteVPNAutoResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The settings of an `auto` VPN. Required when `type` is `auto`.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"remote_site": schema.StringAttribute{
+				MarkdownDescription: "The name of the site on the controller to connect to, e.g. `default`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (m *SiteVPNAutoResourceModel) toUnifiNetwork(network *unifi.Network, sites []unifi.Site) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m == nil {
+		network.RemoteSiteID = ""
+		return diags
+	}
+
+	for _, site := range sites {
+		if site.Name == m.RemoteSite.ValueString() {
+			network.RemoteSiteID = site.ID
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("auto").AtName("remote_site"),
+		"Site Not Found",
+		fmt.Sprintf("The site %q doesn't exist on the controller.", m.RemoteSite.ValueString()),
+	)
+
+	return diags
+}
+
+func newSiteVPNAutoResourceModel(network *unifi.Network, sites []unifi.Site, model *SiteVPNAutoResourceModel) *SiteVPNAutoResourceModel {
+	if network.VPNType == nil || *network.VPNType != vpnTypeAuto {
+		return nil
+	}
+
+	if model == nil {
+		model = &SiteVPNAutoResourceModel{}
+	}
+
+	model.RemoteSite = types.StringNull()
+	for _, site := range sites {
+		if site.ID == network.RemoteSiteID {
+			model.RemoteSite = types.StringValue(site.Name)
+		}
+	}
+
+	return model
+}
+
+type SiteVPNIPsecResourceModel struct {
+	ESPDHGroup    types.Int32         `tfsdk:"esp_dh_group"`
+	ESPEncryption types.String        `tfsdk:"esp_encryption"`
+	ESPHash       types.String        `tfsdk:"esp_hash"`
+	ESPLifetime   types.Int32         `tfsdk:"esp_lifetime"`
+	IKEDHGroup    types.Int32         `tfsdk:"ike_dh_group"`
+	IKEEncryption types.String        `tfsdk:"ike_encryption"`
+	IKEHash       types.String        `tfsdk:"ike_hash"`
+	IKELifetime   types.Int32         `tfsdk:"ike_lifetime"`
+	IKEVersion    types.String        `tfsdk:"ike_version"`
+	Interface     types.String        `tfsdk:"interface"`
+	LocalIP       iptypes.IPv4Address `tfsdk:"local_ip"`
+	PeerIP        iptypes.IPv4Address `tfsdk:"peer_ip"`
+	PFS           types.Bool          `tfsdk:"pfs"`
+	PreSharedKey  types.String        `tfsdk:"pre_shared_key"`
+}
+
+func (m *SiteVPNIPsecResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The settings of an `ipsec` VPN. Required when `type` is `ipsec`.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"esp_dh_group": ipsecDHGroupSchema("The Diffie-Hellman group used for perfect forward secrecy."),
+			"esp_encryption": ipsecEncryptionSchema("The encryption of the traffic sent over the tunnel " +
+				"(phase 2)."),
+			"esp_hash":       ipsecHashSchema("The hash used to authenticate the traffic sent over the tunnel (phase 2)."),
+			"esp_lifetime":   ipsecLifetimeSchema("The lifetime of the tunnel keys (phase 2).", 3600),
+			"ike_dh_group":   ipsecDHGroupSchema("The Diffie-Hellman group used for the key exchange (phase 1)."),
+			"ike_encryption": ipsecEncryptionSchema("The encryption of the key exchange (phase 1)."),
+			"ike_hash":       ipsecHashSchema("The hash used to authenticate the key exchange (phase 1)."),
+			"ike_lifetime":   ipsecLifetimeSchema("The lifetime of the key exchange (phase 1).", 28800),
+			"ike_version": schema.StringAttribute{
+				MarkdownDescription: "The version of IKE used. One of `ikev1` or `ikev2`. Default: `ikev2`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("ikev2"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("ikev1", "ikev2"),
+				},
+			},
+			"interface": vpnServerInterfaceSchema(),
+			"local_ip": schema.StringAttribute{
+				MarkdownDescription: "The local address of the tunnel. When not set any address of `interface` is used.",
+				CustomType:          iptypes.IPv4AddressType{},
+				Optional:            true,
+			},
+			"peer_ip": schema.StringAttribute{
+				MarkdownDescription: "The public address of the remote gateway.",
+				CustomType:          iptypes.IPv4AddressType{},
+				Required:            true,
+			},
+			"pfs": schema.BoolAttribute{
+				MarkdownDescription: "Whether perfect forward secrecy is used. Default: `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"pre_shared_key": schema.StringAttribute{
+				MarkdownDescription: "The key both ends of the tunnel authenticate with.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^"' ]+$`), "must not contain quotes or spaces"),
+				},
+			},
+		},
+	}
+}
+
+func (m *SiteVPNIPsecResourceModel) toUnifiNetwork(network *unifi.Network) {
+	if m == nil {
+		return
+	}
+
+	network.IPSecProfile = utils.StringPtr(ipsecProfileCustomized)
+	network.IPSecInterface = m.Interface.ValueStringPointer()
+	network.IPSecKeyExchange = m.IKEVersion.ValueStringPointer()
+	network.IPSecPeerIP = m.PeerIP.ValueStringPointer()
+	network.XIPSecPreSharedKey = m.PreSharedKey.ValueStringPointer()
+
+	network.IPSecLocalIP = utils.StringPtr("any")
+	if !m.LocalIP.IsNull() {
+		network.IPSecLocalIP = m.LocalIP.ValueStringPointer()
+	}
+
+	network.IPSecIkeDhGroup = utils.IntPtrValue(m.IKEDHGroup.ValueInt32Pointer())
+	network.IPSecIkeEncryption = m.IKEEncryption.ValueStringPointer()
+	network.IPSecIkeHash = m.IKEHash.ValueStringPointer()
+	network.IPSecIkeLifetime = utils.StringPtr(strconv.Itoa(int(m.IKELifetime.ValueInt32())))
+
+	network.IPSecPfs = m.PFS.ValueBool()
+	network.IPSecEspDhGroup = utils.IntPtrValue(m.ESPDHGroup.ValueInt32Pointer())
+	network.IPSecEspEncryption = m.ESPEncryption.ValueStringPointer()
+	network.IPSecEspHash = m.ESPHash.ValueStringPointer()
+	network.IPSecEspLifetime = utils.StringPtr(strconv.Itoa(int(m.ESPLifetime.ValueInt32())))
+}
+
+func newSiteVPNIPsecResourceModel(network *unifi.Network, model *SiteVPNIPsecResourceModel) *SiteVPNIPsecResourceModel {
+	if network.VPNType == nil || *network.VPNType != vpnTypeIPsec {
+		return nil
+	}
+
+	if model == nil {
+		model = &SiteVPNIPsecResourceModel{}
+	}
+
+	model.IKEVersion = types.StringPointerValue(network.IPSecKeyExchange)
+	model.Interface = types.StringPointerValue(network.IPSecInterface)
+	model.PeerIP = iptypes.NewIPv4AddressPointerValue(network.IPSecPeerIP)
+
+	model.LocalIP = iptypes.NewIPv4AddressNull()
+	if network.IPSecLocalIP != nil && *network.IPSecLocalIP != "any" && *network.IPSecLocalIP != "" {
+		model.LocalIP = iptypes.NewIPv4AddressValue(*network.IPSecLocalIP)
+	}
+
+	// The pre-shared key isn't always returned, so keep the configured value when it's missing.
+	if network.XIPSecPreSharedKey != nil && *network.XIPSecPreSharedKey != "" || model.PreSharedKey.IsNull() {
+		model.PreSharedKey = types.StringPointerValue(network.XIPSecPreSharedKey)
+	}
+
+	model.IKEDHGroup = types.Int32PointerValue(utils.Int32PtrValue(network.IPSecIkeDhGroup))
+	model.IKEEncryption = types.StringPointerValue(network.IPSecIkeEncryption)
+	model.IKEHash = types.StringPointerValue(network.IPSecIkeHash)
+	model.IKELifetime = ipsecLifetimeValue(network.IPSecIkeLifetime)
+
+	model.PFS = types.BoolValue(network.IPSecPfs)
+	model.ESPDHGroup = types.Int32PointerValue(utils.Int32PtrValue(network.IPSecEspDhGroup))
+	model.ESPEncryption = types.StringPointerValue(network.IPSecEspEncryption)
+	model.ESPHash = types.StringPointerValue(network.IPSecEspHash)
+	model.ESPLifetime = ipsecLifetimeValue(network.IPSecEspLifetime)
+
+	return model
+}
+
+type SiteVPNOpenVPNResourceModel struct {
+	Interface       types.String        `tfsdk:"interface"`
+	LocalPort       types.Int32         `tfsdk:"local_port"`
+	LocalTunnelIP   iptypes.IPv4Address `tfsdk:"local_tunnel_ip"`
+	RemoteHost      types.String        `tfsdk:"remote_host"`
+	RemotePort      types.Int32         `tfsdk:"remote_port"`
+	RemoteTunnelIP  iptypes.IPv4Address `tfsdk:"remote_tunnel_ip"`
+	SharedSecretKey types.String        `tfsdk:"shared_secret_key"`
+}
+
+func (m *SiteVPNOpenVPNResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The settings of an `openvpn` VPN. Required when `type` is `openvpn`.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"interface": vpnServerInterfaceSchema(),
+			"local_port": schema.Int32Attribute{
+				MarkdownDescription: "The port the tunnel listens on locally. Default: `1194`",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(1194),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"local_tunnel_ip": schema.StringAttribute{
+				MarkdownDescription: "The address of this end of the tunnel.",
+				CustomType:          iptypes.IPv4AddressType{},
+				Required:            true,
+			},
+			"remote_host": schema.StringAttribute{
+				MarkdownDescription: "The public hostname or address of the remote gateway.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dnsNameRegexp, "must be a hostname or IPv4 address"),
+				},
+			},
+			"remote_port": schema.Int32Attribute{
+				MarkdownDescription: "The port the remote end of the tunnel listens on. Default: `1194`",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(1194),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"remote_tunnel_ip": schema.StringAttribute{
+				MarkdownDescription: "The address of the remote end of the tunnel.",
+				CustomType:          iptypes.IPv4AddressType{},
+				Required:            true,
+			},
+			"shared_secret_key": schema.StringAttribute{
+				MarkdownDescription: "The static key both ends of the tunnel share, as 512 hex characters.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9A-Fa-f]{512}$`), "must be 512 hex characters"),
+				},
+			},
+		},
+	}
+}
+
+func (m *SiteVPNOpenVPNResourceModel) toUnifiNetwork(network *unifi.Network) {
+	if m == nil {
+		return
+	}
+
+	network.OpenVPNMode = utils.StringPtr(openVPNModeSiteToSite)
+	network.OpenVPNInterface = m.Interface.ValueStringPointer()
+	network.OpenVPNLocalAddress = m.LocalTunnelIP.ValueStringPointer()
+	network.OpenVPNLocalPort = utils.IntPtrValue(m.LocalPort.ValueInt32Pointer())
+	network.OpenVPNRemoteAddress = m.RemoteTunnelIP.ValueStringPointer()
+	network.OpenVPNRemoteHost = m.RemoteHost.ValueStringPointer()
+	network.OpenVPNRemotePort = utils.IntPtrValue(m.RemotePort.ValueInt32Pointer())
+	network.XOpenVPNSharedSecretKey = m.SharedSecretKey.ValueStringPointer()
+}
+
+func newSiteVPNOpenVPNResourceModel(network *unifi.Network, model *SiteVPNOpenVPNResourceModel) *SiteVPNOpenVPNResourceModel {
+	if network.VPNType == nil || *network.VPNType != vpnTypeOpenVPN {
+		return nil
+	}
+
+	if model == nil {
+		model = &SiteVPNOpenVPNResourceModel{}
+	}
+
+	model.Interface = types.StringPointerValue(network.OpenVPNInterface)
+	model.LocalPort = types.Int32PointerValue(utils.Int32PtrValue(network.OpenVPNLocalPort))
+	model.LocalTunnelIP = iptypes.NewIPv4AddressPointerValue(network.OpenVPNLocalAddress)
+	model.RemoteHost = types.StringPointerValue(network.OpenVPNRemoteHost)
+	model.RemotePort = types.Int32PointerValue(utils.Int32PtrValue(network.OpenVPNRemotePort))
+	model.RemoteTunnelIP = iptypes.NewIPv4AddressPointerValue(network.OpenVPNRemoteAddress)
+
+	// The shared secret isn't always returned, so keep the configured value when it's missing.
+	if network.XOpenVPNSharedSecretKey != nil && *network.XOpenVPNSharedSecretKey != "" || model.SharedSecretKey.IsNull() {
+		model.SharedSecretKey = types.StringPointerValue(network.XOpenVPNSharedSecretKey)
+	}
+
+	return model
+}
+
+func ipsecDHGroupSchema(description string) schema.Attribute {
+	return schema.Int32Attribute{
+		MarkdownDescription: description + " Default: `14`",
+		Computed:            true,
+		Optional:            true,
+		Default:             int32default.StaticInt32(14),
+		Validators: []validator.Int32{
+			int32validator.OneOf(ipsecDHGroups...),
+		},
+	}
+}
+
+func ipsecEncryptionSchema(description string) schema.Attribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description + " One of `aes128`, `aes192`, `aes256` or `3des`. Default: `aes256`",
+		Computed:            true,
+		Optional:            true,
+		Default:             stringdefault.StaticString("aes256"),
+		Validators: []validator.String{
+			stringvalidator.OneOf(ipsecEncryptions...),
+		},
+	}
+}
+
+func ipsecHashSchema(description string) schema.Attribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description + " One of `sha1`, `md5`, `sha256`, `sha384` or `sha512`. Default: `sha256`",
+		Computed:            true,
+		Optional:            true,
+		Default:             stringdefault.StaticString("sha256"),
+		Validators: []validator.String{
+			stringvalidator.OneOf(ipsecHashes...),
+		},
+	}
+}
+
+func ipsecLifetimeSchema(description string, defaultLifetime int32) schema.Attribute {
+	return schema.Int32Attribute{
+		MarkdownDescription: fmt.Sprintf("%s In seconds. Default: `%d`", description, defaultLifetime),
+		Computed:            true,
+		Optional:            true,
+		Default:             int32default.StaticInt32(defaultLifetime),
+		Validators: []validator.Int32{
+			int32validator.Between(30, 86400),
+		},
+	}
+}
+
+// ipsecLifetimeValue converts the lifetimes the controller returns as strings in to a types.Int32.
+func ipsecLifetimeValue(v *string) types.Int32 {
+	if v == nil {
+		return types.Int32Null()
+	}
+
+	lifetime, err := strconv.Atoi(*v)
+	if err != nil {
+		return types.Int32Null()
+	}
+
+	return types.Int32Value(int32(lifetime))
+}
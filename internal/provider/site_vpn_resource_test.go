@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccSiteVPNResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSiteVPNConfig("ipsec", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccSiteVPNConfig("auto", `
+  remote_subnets = ["10.1.0.0/24"]
+
+  auto = {
+    remote_site = "default"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccSiteVPNConfig("ipsec", `
+  remote_subnets = ["10.1.0.0/24"]
+
+  ipsec = {
+    peer_ip        = "203.0.113.10"
+    pre_shared_key = "secret"
+    ike_dh_group   = 3
+  }
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+func TestAccSiteVPNResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSiteVPNConfig("ipsec", `
+  remote_subnets = ["10.1.0.0/24"]
+
+  ipsec = {
+    peer_ip        = "203.0.113.10"
+    pre_shared_key = "secret"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "ipsec.ike_version", "ikev2"),
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "ipsec.ike_encryption", "aes256"),
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "ipsec.ike_lifetime", "28800"),
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "ipsec.esp_lifetime", "3600"),
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "ipsec.pfs", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "unifi_site_vpn.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipsec.pre_shared_key"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSiteVPNConfig("ipsec", `
+  remote_subnets = ["10.1.0.0/24", "10.2.0.0/24"]
+
+  ipsec = {
+    peer_ip        = "203.0.113.10"
+    pre_shared_key = "secret"
+    ike_version    = "ikev1"
+    ike_hash       = "sha512"
+    esp_dh_group   = 19
+    pfs            = false
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "remote_subnets.#", "2"),
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "ipsec.ike_version", "ikev1"),
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "ipsec.ike_hash", "sha512"),
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "ipsec.esp_dh_group", "19"),
+					resource.TestCheckResourceAttr("unifi_site_vpn.test", "ipsec.pfs", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSiteVPNConfig(vpnType, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_site_vpn" "test" {
+  name = "Test Site VPN"
+  type = %q
+  %s
+}
+`, vpnType, settings)
+}
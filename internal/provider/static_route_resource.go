@@ -0,0 +1,495 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"net/netip"
+)
+
+const (
+	staticRouteTypeBlackhole = "blackhole"
+	staticRouteTypeInterface = "interface-route"
+	staticRouteTypeNextHop   = "nexthop-route"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &StaticRouteResource{}
+	_ resource.ResourceWithImportState    = &StaticRouteResource{}
+	_ resource.ResourceWithModifyPlan     = &StaticRouteResource{}
+	_ resource.ResourceWithValidateConfig = &StaticRouteResource{}
+
+	defaultStaticRouteResourceModel = StaticRouteResourceModel{}
+)
+
+func NewStaticRouteResource() resource.Resource {
+	return &StaticRouteResource{}
+}
+
+// StaticRouteResource defines the resource implementation.
+type StaticRouteResource struct {
+	client *unifiClient
+}
+
+func (r *StaticRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_static_route"
+}
+
+func (r *StaticRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultStaticRouteResourceModel.schema()
+}
+
+func (r *StaticRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StaticRouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StaticRouteResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+// ModifyPlan warns when an IPv4 next hop isn't within one of the site's networks. The controller accepts a route to any
+// next hop, but one it can't reach never becomes active. This is only a warning as the network may be created in the
+// same apply, which can't be seen from here.
+func (r *StaticRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or when the provider hasn't been configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan StaticRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.NextHop.IsNull() || plan.NextHop.IsUnknown() {
+		return
+	}
+
+	nextHop, err := netip.ParseAddr(plan.NextHop.ValueString())
+	if err != nil || !nextHop.Is4() {
+		return
+	}
+
+	site := r.client.site
+	if plan.Site.ValueString() != "" {
+		site = plan.Site.ValueString()
+	}
+
+	networks, err := r.client.ListNetwork(ctx, site)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list networks, got error: %s", err))
+		return
+	}
+
+	dynamicWAN := false
+	for _, network := range networks {
+		subnet, ok := networkSubnet(network)
+		if ok && subnet.Contains(nextHop) {
+			return
+		}
+
+		if !ok && network.Purpose != nil && *network.Purpose == networkPurposeWAN {
+			dynamicWAN = true
+		}
+	}
+
+	// The subnet of a WAN that gets its address from the ISP isn't known, so a public next hop may well be on it.
+	if dynamicWAN && !nextHop.IsPrivate() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("next_hop"),
+		"Unreachable Next Hop",
+		fmt.Sprintf("The next hop %s is not within any of the existing networks in the %s site, so the route won't "+
+			"be used unless a network containing it is created in the same apply.", nextHop, site),
+	)
+}
+
+func (r *StaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StaticRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	route := &unifi.Routing{
+		Type: utils.StringPtr("static-route"),
+	}
+
+	data.toUnifiRouting(route)
+
+	route, err := r.client.CreateRouting(ctx, site, route)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create static route, got error: %s", err))
+		return
+	}
+
+	data = newStaticRouteResourceModel(route, site, data)
+
+	tflog.Trace(ctx, "Static route created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StaticRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	route, err := r.client.GetRouting(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read static route, got error: %s", err))
+		return
+	}
+
+	data = newStaticRouteResourceModel(route, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StaticRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current route so settings that aren't managed by the resource are left untouched.
+	route, err := r.client.GetRouting(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read static route, got error: %s", err))
+		return
+	}
+
+	data.toUnifiRouting(route)
+
+	route, err = r.client.UpdateRouting(ctx, site, route)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update static route, got error: %s", err))
+		return
+	}
+
+	data = newStaticRouteResourceModel(route, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StaticRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteRouting(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete static route, got error: %s", err))
+		return
+	}
+}
+
+func (r *StaticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type StaticRouteResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	Destination types.String `tfsdk:"destination"`
+	Distance    types.Int32  `tfsdk:"distance"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Interface   types.String `tfsdk:"interface"`
+	Name        types.String `tfsdk:"name"`
+	NextHop     types.String `tfsdk:"next_hop"`
+	Site        types.String `tfsdk:"site"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func (m *StaticRouteResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi static route.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi static route identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 or IPv6 CIDR the route is for, e.g. `10.20.0.0/16`.",
+				Required:            true,
+			},
+			"distance": schema.Int32Attribute{
+				MarkdownDescription: "The administrative distance of the route. Routes with a lower distance are " +
+					"preferred. Default: `1`",
+				Computed: true,
+				Optional: true,
+				Default:  int32default.StaticInt32(1),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 255),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The interface to send traffic out of for an `interface-route`. Either `WAN1`, " +
+					"`WAN2` or the ID of a network.",
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"next_hop": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 or IPv6 address of the router to send traffic to for a " +
+					"`nexthop-route`. IPv4 addresses must be within one of the site's networks for the route to be " +
+					"used, plans warn when they aren't.",
+				Optional: true,
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the static route belongs to. Setting this overrides the default site " +
+					"set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of route. `nexthop-route` sends traffic to `next_hop`, " +
+					"`interface-route` sends traffic out of `interface` and `blackhole` drops the traffic.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(staticRouteTypeBlackhole, staticRouteTypeInterface, staticRouteTypeNextHop),
+					customvalidator.StringValueWithPaths(staticRouteTypeInterface, path.MatchRoot("interface")),
+					customvalidator.StringValueConflictsWithPaths(staticRouteTypeInterface, path.MatchRoot("next_hop")),
+					customvalidator.StringValueWithPaths(staticRouteTypeNextHop, path.MatchRoot("next_hop")),
+					customvalidator.StringValueConflictsWithPaths(staticRouteTypeNextHop, path.MatchRoot("interface")),
+					customvalidator.StringValueConflictsWithPaths(staticRouteTypeBlackhole,
+						path.MatchRoot("interface"),
+						path.MatchRoot("next_hop"),
+					),
+				},
+			},
+		},
+	}
+}
+
+// validate checks the destination and next hop are valid addresses of the same family.
+func (m *StaticRouteResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.Destination.IsNull() || m.Destination.IsUnknown() {
+		return diags
+	}
+
+	destination, err := netip.ParsePrefix(m.Destination.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("destination"),
+			"Invalid Static Route",
+			fmt.Sprintf("The destination must be an IPv4 or IPv6 CIDR, got: %s.", m.Destination.ValueString()),
+		)
+
+		return diags
+	}
+
+	if destination != destination.Masked() {
+		diags.AddAttributeError(
+			path.Root("destination"),
+			"Invalid Static Route",
+			fmt.Sprintf("The destination must be a network address, e.g. %s, got: %s.", destination.Masked(), destination),
+		)
+	}
+
+	if m.NextHop.IsNull() || m.NextHop.IsUnknown() {
+		return diags
+	}
+
+	nextHop, err := netip.ParseAddr(m.NextHop.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("next_hop"),
+			"Invalid Static Route",
+			fmt.Sprintf("The next hop must be an IPv4 or IPv6 address, got: %s.", m.NextHop.ValueString()),
+		)
+
+		return diags
+	}
+
+	if nextHop.Is4() != destination.Addr().Is4() {
+		diags.AddAttributeError(
+			path.Root("next_hop"),
+			"Invalid Static Route",
+			fmt.Sprintf("The next hop %s must be the same IP version as the destination %s.", nextHop, destination),
+		)
+	}
+
+	return diags
+}
+
+func (m *StaticRouteResourceModel) toUnifiRouting(route *unifi.Routing) {
+	route.Enabled = m.Enabled.ValueBool()
+	route.Name = m.Name.ValueStringPointer()
+	route.StaticRouteDistance = utils.IntPtrValue(m.Distance.ValueInt32Pointer())
+	route.StaticRouteInterface = m.Interface.ValueString()
+	route.StaticRouteNetwork = m.Destination.ValueStringPointer()
+	route.StaticRouteNexthop = m.NextHop.ValueString()
+	route.StaticRouteType = m.Type.ValueStringPointer()
+}
+
+func newStaticRouteResourceModel(route *unifi.Routing, site string, model StaticRouteResourceModel) StaticRouteResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(route.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(route.SiteID)
+
+	// Configurable Values
+	model.Destination = types.StringPointerValue(route.StaticRouteNetwork)
+	model.Distance = types.Int32PointerValue(utils.Int32PtrValue(route.StaticRouteDistance))
+	model.Enabled = types.BoolValue(route.Enabled)
+	model.Interface = utils.EmptyStringNull(&route.StaticRouteInterface)
+	model.Name = types.StringPointerValue(route.Name)
+	model.NextHop = utils.EmptyStringNull(&route.StaticRouteNexthop)
+	model.Type = types.StringPointerValue(route.StaticRouteType)
+
+	return model
+}
+
+// networkSubnet returns the IPv4 subnet of a network, if it's known. For LANs this is the configured subnet and for
+// WANs it's only known when they have a static IP.
+func networkSubnet(network unifi.Network) (netip.Prefix, bool) {
+	if network.IPSubnet != nil && *network.IPSubnet != "" {
+		subnet, err := netip.ParsePrefix(*network.IPSubnet)
+		return subnet.Masked(), err == nil
+	}
+
+	if network.WANIP == nil || network.WANNetmask == nil {
+		return netip.Prefix{}, false
+	}
+
+	ip, err := netip.ParseAddr(*network.WANIP)
+	if err != nil || !ip.Is4() {
+		return netip.Prefix{}, false
+	}
+
+	netmask, err := netip.ParseAddr(*network.WANNetmask)
+	if err != nil || !netmask.Is4() {
+		return netip.Prefix{}, false
+	}
+
+	bits := 0
+	for _, b := range netmask.As4() {
+		for ; b != 0; b <<= 1 {
+			bits++
+		}
+	}
+
+	return netip.PrefixFrom(ip, bits).Masked(), true
+}
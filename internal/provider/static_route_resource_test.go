@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccStaticRouteResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStaticRouteConfig("interface-route", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccStaticRouteConfig("blackhole", `next_hop = "192.168.1.1"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccStaticRouteConfig("nexthop-route", `next_hop = "2001:db8::1"`),
+				ExpectError: regexp.MustCompile(`must be the same IP version as the destination`),
+			},
+		},
+	})
+}
+
+func TestAccStaticRouteResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStaticRouteConfig("blackhole", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_static_route.test", "name", "Test Route"),
+					resource.TestCheckResourceAttr("unifi_static_route.test", "type", "blackhole"),
+					resource.TestCheckResourceAttr("unifi_static_route.test", "destination", "10.20.0.0/16"),
+					resource.TestCheckResourceAttr("unifi_static_route.test", "distance", "1"),
+					resource.TestCheckResourceAttr("unifi_static_route.test", "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_static_route.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccStaticRouteConfig("interface-route", `
+  interface = "WAN1"
+  distance  = 10
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_static_route.test", "type", "interface-route"),
+					resource.TestCheckResourceAttr("unifi_static_route.test", "interface", "WAN1"),
+					resource.TestCheckResourceAttr("unifi_static_route.test", "distance", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStaticRouteResource_NextHopInNewNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The next hop is only reachable once the network is created, which must not stop the route being planned.
+			{
+				Config: testAccStaticRouteConfig("nexthop-route", `next_hop = "172.31.255.10"`) + `
+resource "unifi_network" "test" {
+  name    = "Test Network"
+  subnet  = "172.31.255.1/24"
+  vlan_id = 51
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_static_route.test", "next_hop", "172.31.255.10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStaticRouteConfig(routeType, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_static_route" "test" {
+  name        = "Test Route"
+  type        = %q
+  destination = "10.20.0.0/16"
+  %s
+}
+`, routeType, settings)
+}
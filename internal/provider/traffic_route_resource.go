@@ -0,0 +1,378 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &TrafficRouteResource{}
+	_ resource.ResourceWithImportState = &TrafficRouteResource{}
+
+	defaultTrafficRouteResourceModel = TrafficRouteResourceModel{}
+)
+
+func NewTrafficRouteResource() resource.Resource {
+	return &TrafficRouteResource{}
+}
+
+// TrafficRouteResource defines the resource implementation.
+type TrafficRouteResource struct {
+	client *unifiClient
+}
+
+func (r *TrafficRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_route"
+}
+
+func (r *TrafficRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultTrafficRouteResourceModel.schema()
+}
+
+func (r *TrafficRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrafficRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TrafficRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	route := &trafficRoute{}
+	resp.Diagnostics.Append(data.toTrafficRoute(ctx, route)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := r.client.createTrafficRoute(ctx, site, route)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create traffic route, got error: %s", err))
+		return
+	}
+
+	data, diags := newTrafficRouteResourceModel(ctx, route, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Traffic route created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrafficRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TrafficRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	route, err := r.client.getTrafficRoute(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic route, got error: %s", err))
+		return
+	}
+
+	data, diags := newTrafficRouteResourceModel(ctx, route, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrafficRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TrafficRouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current route so settings that aren't managed by the resource, such as IP ranges, are left
+	// untouched.
+	route, err := r.client.getTrafficRoute(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic route, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toTrafficRoute(ctx, route)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err = r.client.updateTrafficRoute(ctx, site, route)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update traffic route, got error: %s", err))
+		return
+	}
+
+	data, diags := newTrafficRouteResourceModel(ctx, route, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrafficRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TrafficRouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.deleteTrafficRoute(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete traffic route, got error: %s", err))
+		return
+	}
+}
+
+func (r *TrafficRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type TrafficRouteResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	Description       types.String        `tfsdk:"description"`
+	Domains           types.Set           `tfsdk:"domains"`
+	Enabled           types.Bool          `tfsdk:"enabled"`
+	IPAddresses       types.Set           `tfsdk:"ip_addresses"`
+	KillSwitchEnabled types.Bool          `tfsdk:"kill_switch_enabled"`
+	MatchingTarget    types.String        `tfsdk:"matching_target"`
+	NetworkID         types.String        `tfsdk:"network_id"`
+	NextHop           iptypes.IPv4Address `tfsdk:"next_hop"`
+	Regions           types.Set           `tfsdk:"regions"`
+	Site              types.String        `tfsdk:"site"`
+	TargetClientMACs  types.Set           `tfsdk:"target_client_macs"`
+	TargetNetworkIDs  types.Set           `tfsdk:"target_network_ids"`
+}
+
+func (m *TrafficRouteResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A traffic route, which sends traffic to the internet, a domain, IP address or region " +
+			"through a specific WAN or VPN client network. Traffic routes require a controller with the v2 API.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi traffic route identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"description": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"domains": trafficDomainsSchema(),
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"ip_addresses": trafficIPAddressesSchema(),
+			"kill_switch_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the matching traffic is blocked, rather than sent through the default " +
+					"route, while the network is down.",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"matching_target": schema.StringAttribute{
+				MarkdownDescription: "The traffic the route applies to. One of `INTERNET`, `DOMAIN`, `IP` or `REGION`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						trafficMatchingTargetDomain,
+						trafficMatchingTargetInternet,
+						trafficMatchingTargetIP,
+						trafficMatchingTargetRegion,
+					),
+					customvalidator.StringValueWithPaths(trafficMatchingTargetDomain, path.MatchRoot("domains")),
+					customvalidator.StringValueWithPaths(trafficMatchingTargetIP, path.MatchRoot("ip_addresses")),
+					customvalidator.StringValueWithPaths(trafficMatchingTargetRegion, path.MatchRoot("regions")),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "The WAN or VPN client network the matching traffic is routed through.",
+				Required:            true,
+			},
+			"next_hop": schema.StringAttribute{
+				MarkdownDescription: "The gateway the matching traffic is sent to. When not set the gateway of the " +
+					"network is used.",
+				CustomType: iptypes.IPv4AddressType{},
+				Optional:   true,
+			},
+			"regions": trafficRegionsSchema(),
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the traffic route belongs to. Setting this overrides the default site " +
+					"set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_client_macs": trafficTargetClientMACsSchema(),
+			"target_network_ids": trafficTargetNetworkIDsSchema(),
+		},
+	}
+}
+
+func (m *TrafficRouteResourceModel) toTrafficRoute(ctx context.Context, route *trafficRoute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	route.Description = m.Description.ValueString()
+	route.Enabled = m.Enabled.ValueBool()
+	route.KillSwitchEnabled = m.KillSwitchEnabled.ValueBool()
+	route.MatchingTarget = m.MatchingTarget.ValueString()
+	route.NetworkID = m.NetworkID.ValueString()
+	route.NextHop = m.NextHop.ValueString()
+
+	route.Regions = make([]string, 0)
+	diags.Append(utils.SetValueStrings(ctx, m.Regions, &route.Regions)...)
+
+	var d diag.Diagnostics
+	route.Domains, d = toTrafficDomains(ctx, m.Domains)
+	diags.Append(d...)
+	route.IPAddresses, d = toTrafficIPAddresses(ctx, m.IPAddresses)
+	diags.Append(d...)
+	route.TargetDevices, d = toTrafficTargetDevices(ctx, m.TargetClientMACs, m.TargetNetworkIDs)
+	diags.Append(d...)
+
+	if route.IPRanges == nil {
+		route.IPRanges = make([]json.RawMessage, 0)
+	}
+
+	return diags
+}
+
+func newTrafficRouteResourceModel(ctx context.Context, route *trafficRoute, site string, model TrafficRouteResourceModel) (TrafficRouteResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(route.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.Description = types.StringValue(route.Description)
+	model.Enabled = types.BoolValue(route.Enabled)
+	model.KillSwitchEnabled = types.BoolValue(route.KillSwitchEnabled)
+	model.MatchingTarget = types.StringValue(route.MatchingTarget)
+	model.NetworkID = types.StringValue(route.NetworkID)
+
+	model.NextHop = iptypes.NewIPv4AddressNull()
+	if route.NextHop != "" {
+		model.NextHop = iptypes.NewIPv4AddressValue(route.NextHop)
+	}
+
+	var d diag.Diagnostics
+	model.Regions, d = utils.StringSetValue(ctx, route.Regions)
+	diags.Append(d...)
+	model.Domains, d = newTrafficDomainsValue(ctx, route.Domains)
+	diags.Append(d...)
+	model.IPAddresses, d = newTrafficIPAddressesValue(ctx, route.IPAddresses)
+	diags.Append(d...)
+	model.TargetClientMACs, model.TargetNetworkIDs, d = newTrafficTargetDevicesValues(ctx, route.TargetDevices)
+	diags.Append(d...)
+
+	return model, diags
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccTrafficRouteResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTrafficRouteConfig("APP", ""),
+				ExpectError: regexp.MustCompile(`Attribute matching_target value must be one of`),
+			},
+			{
+				Config:      testAccTrafficRouteConfig("REGION", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccTrafficRouteConfig("INTERNET", `
+  target_client_macs = ["00:00:5e:00:53:01"]
+  target_network_ids = ["000000000000000000000000"]
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccTrafficRouteResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTrafficRouteConfigVPNClient("DOMAIN", `domains = ["example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_traffic_route.test", "description", "Test Route"),
+					resource.TestCheckResourceAttr("unifi_traffic_route.test", "matching_target", "DOMAIN"),
+					resource.TestCheckResourceAttr("unifi_traffic_route.test", "domains.#", "1"),
+					resource.TestCheckResourceAttr("unifi_traffic_route.test", "enabled", "true"),
+					resource.TestCheckResourceAttrPair("unifi_traffic_route.test", "network_id", "unifi_vpn_client.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_traffic_route.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTrafficRouteConfigVPNClient("REGION", `
+  regions             = ["GB", "US"]
+  kill_switch_enabled = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_traffic_route.test", "matching_target", "REGION"),
+					resource.TestCheckResourceAttr("unifi_traffic_route.test", "regions.#", "2"),
+					resource.TestCheckResourceAttr("unifi_traffic_route.test", "kill_switch_enabled", "true"),
+					resource.TestCheckNoResourceAttr("unifi_traffic_route.test", "domains"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrafficRouteConfig(matchingTarget, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_traffic_route" "test" {
+  description     = "Test Route"
+  network_id      = "000000000000000000000000"
+  matching_target = %q
+  %s
+}
+`, matchingTarget, settings)
+}
+
+// testAccTrafficRouteConfigVPNClient routes the traffic through a VPN client, as a route needs a network to target.
+func testAccTrafficRouteConfigVPNClient(matchingTarget, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_vpn_client" "test" {
+  name = "Test Route VPN"
+  type = "wireguard"
+
+  wireguard = {
+    address         = "10.64.0.2/32"
+    peer_host       = "vpn.example.com"
+    peer_public_key = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+    private_key     = "GL2Ghb4dCyltmBO0T8R5nPeuvzMBT5wi9oUuWALnH04="
+  }
+}
+
+resource "unifi_traffic_route" "test" {
+  description     = "Test Route"
+  network_id      = unifi_vpn_client.test.id
+  matching_target = %q
+  %s
+}
+`, matchingTarget, settings)
+}
@@ -0,0 +1,812 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customtype"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+const (
+	trafficRuleActionSpeedLimit = "SPEED_LIMIT"
+
+	trafficMatchingTargetApp          = "APP"
+	trafficMatchingTargetAppCategory  = "APP_CATEGORY"
+	trafficMatchingTargetDomain       = "DOMAIN"
+	trafficMatchingTargetInternet     = "INTERNET"
+	trafficMatchingTargetIP           = "IP"
+	trafficMatchingTargetLocalNetwork = "LOCAL_NETWORK"
+	trafficMatchingTargetRegion       = "REGION"
+
+	trafficScheduleModeAlways    = "ALWAYS"
+	trafficScheduleModeEveryDay  = "EVERY_DAY"
+	trafficScheduleModeEveryWeek = "EVERY_WEEK"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &TrafficRuleResource{}
+	_ resource.ResourceWithImportState = &TrafficRuleResource{}
+
+	defaultTrafficRuleResourceModel               = TrafficRuleResourceModel{}
+	defaultTrafficRuleBandwidthLimitResourceModel = TrafficRuleBandwidthLimitResourceModel{}
+	defaultTrafficRuleScheduleResourceModel       = TrafficRuleScheduleResourceModel{}
+
+	trafficRegionRegexp = regexp.MustCompile(`^[A-Z]{2}$`)
+	trafficTimeRegexp   = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+)
+
+func NewTrafficRuleResource() resource.Resource {
+	return &TrafficRuleResource{}
+}
+
+// TrafficRuleResource defines the resource implementation.
+type TrafficRuleResource struct {
+	client *unifiClient
+}
+
+func (r *TrafficRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_rule"
+}
+
+func (r *TrafficRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultTrafficRuleResourceModel.schema()
+}
+
+func (r *TrafficRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrafficRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TrafficRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	rule := &trafficRule{}
+	resp.Diagnostics.Append(data.toTrafficRule(ctx, rule)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.createTrafficRule(ctx, site, rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create traffic rule, got error: %s", err))
+		return
+	}
+
+	data, diags := newTrafficRuleResourceModel(ctx, rule, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Traffic rule created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrafficRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TrafficRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	rule, err := r.client.getTrafficRule(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic rule, got error: %s", err))
+		return
+	}
+
+	data, diags := newTrafficRuleResourceModel(ctx, rule, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrafficRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TrafficRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current rule so settings that aren't managed by the resource are left untouched.
+	rule, err := r.client.getTrafficRule(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read traffic rule, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toTrafficRule(ctx, rule)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err = r.client.updateTrafficRule(ctx, site, rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update traffic rule, got error: %s", err))
+		return
+	}
+
+	data, diags := newTrafficRuleResourceModel(ctx, rule, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrafficRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TrafficRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.deleteTrafficRule(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete traffic rule, got error: %s", err))
+		return
+	}
+}
+
+func (r *TrafficRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type TrafficRuleResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	Action           types.String                            `tfsdk:"action"`
+	AppCategoryIDs   types.Set                               `tfsdk:"app_category_ids"`
+	AppIDs           types.Set                               `tfsdk:"app_ids"`
+	BandwidthLimit   *TrafficRuleBandwidthLimitResourceModel `tfsdk:"bandwidth_limit"`
+	Description      types.String                            `tfsdk:"description"`
+	Domains          types.Set                               `tfsdk:"domains"`
+	Enabled          types.Bool                              `tfsdk:"enabled"`
+	IPAddresses      types.Set                               `tfsdk:"ip_addresses"`
+	MatchingTarget   types.String                            `tfsdk:"matching_target"`
+	NetworkIDs       types.Set                               `tfsdk:"network_ids"`
+	Regions          types.Set                               `tfsdk:"regions"`
+	Schedule         *TrafficRuleScheduleResourceModel       `tfsdk:"schedule"`
+	Site             types.String                            `tfsdk:"site"`
+	TargetClientMACs types.Set                               `tfsdk:"target_client_macs"`
+	TargetNetworkIDs types.Set                               `tfsdk:"target_network_ids"`
+}
+
+func (m *TrafficRuleResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A traffic rule, which blocks, allows or limits the speed of traffic to an app, domain, " +
+			"IP address, region or network. Traffic rules require a controller with the v2 API.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi traffic rule identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"action": schema.StringAttribute{
+				MarkdownDescription: "What happens to the matching traffic. One of `BLOCK`, `ALLOW` or `SPEED_LIMIT`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ALLOW", "BLOCK", trafficRuleActionSpeedLimit),
+					customvalidator.StringValueWithPaths(trafficRuleActionSpeedLimit, path.MatchRoot("bandwidth_limit")),
+				},
+			},
+			"app_category_ids": schema.SetAttribute{
+				MarkdownDescription: "The DPI app categories to match when `matching_target` is `APP_CATEGORY`.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"app_ids": schema.SetAttribute{
+				MarkdownDescription: "The DPI apps to match when `matching_target` is `APP`.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"bandwidth_limit": defaultTrafficRuleBandwidthLimitResourceModel.schema(),
+			"description": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"domains": trafficDomainsSchema(),
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"ip_addresses": trafficIPAddressesSchema(),
+			"matching_target": schema.StringAttribute{
+				MarkdownDescription: "The traffic the rule applies to. One of `INTERNET`, `APP`, `APP_CATEGORY`, " +
+					"`DOMAIN`, `IP`, `LOCAL_NETWORK` or `REGION`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						trafficMatchingTargetApp,
+						trafficMatchingTargetAppCategory,
+						trafficMatchingTargetDomain,
+						trafficMatchingTargetInternet,
+						trafficMatchingTargetIP,
+						trafficMatchingTargetLocalNetwork,
+						trafficMatchingTargetRegion,
+					),
+					customvalidator.StringValueWithPaths(trafficMatchingTargetApp, path.MatchRoot("app_ids")),
+					customvalidator.StringValueWithPaths(trafficMatchingTargetAppCategory, path.MatchRoot("app_category_ids")),
+					customvalidator.StringValueWithPaths(trafficMatchingTargetDomain, path.MatchRoot("domains")),
+					customvalidator.StringValueWithPaths(trafficMatchingTargetIP, path.MatchRoot("ip_addresses")),
+					customvalidator.StringValueWithPaths(trafficMatchingTargetLocalNetwork, path.MatchRoot("network_ids")),
+					customvalidator.StringValueWithPaths(trafficMatchingTargetRegion, path.MatchRoot("regions")),
+				},
+			},
+			"network_ids": schema.SetAttribute{
+				MarkdownDescription: "The networks to match when `matching_target` is `LOCAL_NETWORK`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"regions":  trafficRegionsSchema(),
+			"schedule": defaultTrafficRuleScheduleResourceModel.schema(),
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the traffic rule belongs to. Setting this overrides the default site " +
+					"set in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_client_macs": trafficTargetClientMACsSchema(),
+			"target_network_ids": trafficTargetNetworkIDsSchema(),
+		},
+	}
+}
+
+func (m *TrafficRuleResourceModel) toTrafficRule(ctx context.Context, rule *trafficRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rule.Action = m.Action.ValueString()
+	rule.Description = m.Description.ValueString()
+	rule.Enabled = m.Enabled.ValueBool()
+	rule.MatchingTarget = m.MatchingTarget.ValueString()
+
+	rule.AppCategoryIDs = make([]int64, 0)
+	diags.Append(setValueInt64s(ctx, m.AppCategoryIDs, &rule.AppCategoryIDs)...)
+	rule.AppIDs = make([]int64, 0)
+	diags.Append(setValueInt64s(ctx, m.AppIDs, &rule.AppIDs)...)
+	rule.NetworkIDs = make([]string, 0)
+	diags.Append(utils.SetValueStrings(ctx, m.NetworkIDs, &rule.NetworkIDs)...)
+	rule.Regions = make([]string, 0)
+	diags.Append(utils.SetValueStrings(ctx, m.Regions, &rule.Regions)...)
+
+	var d diag.Diagnostics
+	rule.Domains, d = toTrafficDomains(ctx, m.Domains)
+	diags.Append(d...)
+	rule.IPAddresses, d = toTrafficIPAddresses(ctx, m.IPAddresses)
+	diags.Append(d...)
+	rule.TargetDevices, d = toTrafficTargetDevices(ctx, m.TargetClientMACs, m.TargetNetworkIDs)
+	diags.Append(d...)
+
+	if rule.IPRanges == nil {
+		rule.IPRanges = make([]json.RawMessage, 0)
+	}
+
+	rule.BandwidthLimit.Enabled = m.BandwidthLimit != nil
+	if m.BandwidthLimit != nil {
+		rule.BandwidthLimit.DownloadLimitKbps = int64(m.BandwidthLimit.DownloadKbps.ValueInt32())
+		rule.BandwidthLimit.UploadLimitKbps = int64(m.BandwidthLimit.UploadKbps.ValueInt32())
+	}
+
+	diags.Append(m.Schedule.toTrafficSchedule(ctx, &rule.Schedule)...)
+
+	return diags
+}
+
+func newTrafficRuleResourceModel(ctx context.Context, rule *trafficRule, site string, model TrafficRuleResourceModel) (TrafficRuleResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(rule.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.Action = types.StringValue(rule.Action)
+	model.Description = types.StringValue(rule.Description)
+	model.Enabled = types.BoolValue(rule.Enabled)
+	model.MatchingTarget = types.StringValue(rule.MatchingTarget)
+
+	var d diag.Diagnostics
+	model.AppCategoryIDs, d = int64SetValue(ctx, rule.AppCategoryIDs)
+	diags.Append(d...)
+	model.AppIDs, d = int64SetValue(ctx, rule.AppIDs)
+	diags.Append(d...)
+	model.NetworkIDs, d = utils.StringSetValue(ctx, rule.NetworkIDs)
+	diags.Append(d...)
+	model.Regions, d = utils.StringSetValue(ctx, rule.Regions)
+	diags.Append(d...)
+	model.Domains, d = newTrafficDomainsValue(ctx, rule.Domains)
+	diags.Append(d...)
+	model.IPAddresses, d = newTrafficIPAddressesValue(ctx, rule.IPAddresses)
+	diags.Append(d...)
+	model.TargetClientMACs, model.TargetNetworkIDs, d = newTrafficTargetDevicesValues(ctx, rule.TargetDevices)
+	diags.Append(d...)
+
+	model.BandwidthLimit = nil
+	if rule.BandwidthLimit.Enabled {
+		model.BandwidthLimit = &TrafficRuleBandwidthLimitResourceModel{
+			DownloadKbps: types.Int32Value(int32(rule.BandwidthLimit.DownloadLimitKbps)),
+			UploadKbps:   types.Int32Value(int32(rule.BandwidthLimit.UploadLimitKbps)),
+		}
+	}
+
+	model.Schedule, d = newTrafficRuleScheduleResourceModel(ctx, rule.Schedule)
+	diags.Append(d...)
+
+	return model, diags
+}
+
+type TrafficRuleBandwidthLimitResourceModel struct {
+	DownloadKbps types.Int32 `tfsdk:"download_kbps"`
+	UploadKbps   types.Int32 `tfsdk:"upload_kbps"`
+}
+
+func (m *TrafficRuleBandwidthLimitResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The speed limit of the matching traffic when `action` is `SPEED_LIMIT`.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"download_kbps": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"upload_kbps": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+type TrafficRuleScheduleResourceModel struct {
+	Days      types.Set    `tfsdk:"days"`
+	EndTime   types.String `tfsdk:"end_time"`
+	StartTime types.String `tfsdk:"start_time"`
+}
+
+func (m *TrafficRuleScheduleResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "When the rule applies. When not set the rule always applies.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"days": schema.SetAttribute{
+				MarkdownDescription: "The days the rule applies on. Any of `mon`, `tue`, `wed`, `thu`, `fri`, `sat` " +
+					"and `sun`. When not set the rule applies every day.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("mon", "tue", "wed", "thu", "fri", "sat", "sun")),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "The time, as `HH:MM`, the rule stops applying each day.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(trafficTimeRegexp, "must be a time in the form HH:MM"),
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("start_time")),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "The time, as `HH:MM`, the rule starts applying each day. When not set the rule " +
+					"applies all day.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(trafficTimeRegexp, "must be a time in the form HH:MM"),
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("end_time")),
+				},
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.AtLeastOneOf(
+				path.MatchRelative().AtName("days"),
+				path.MatchRelative().AtName("start_time"),
+			),
+		},
+	}
+}
+
+func (m *TrafficRuleScheduleResourceModel) toTrafficSchedule(ctx context.Context, schedule *trafficSchedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schedule.RepeatOnDays = make([]string, 0)
+
+	if m == nil {
+		schedule.Mode = trafficScheduleModeAlways
+		schedule.TimeAllDay = false
+		schedule.TimeRangeStart = ""
+		schedule.TimeRangeEnd = ""
+		return diags
+	}
+
+	schedule.Mode = trafficScheduleModeEveryDay
+	if !m.Days.IsNull() {
+		schedule.Mode = trafficScheduleModeEveryWeek
+		diags.Append(utils.SetValueStrings(ctx, m.Days, &schedule.RepeatOnDays)...)
+	}
+
+	schedule.TimeAllDay = m.StartTime.IsNull()
+	schedule.TimeRangeStart = m.StartTime.ValueString()
+	schedule.TimeRangeEnd = m.EndTime.ValueString()
+
+	return diags
+}
+
+func newTrafficRuleScheduleResourceModel(ctx context.Context, schedule trafficSchedule) (*TrafficRuleScheduleResourceModel, diag.Diagnostics) {
+	if schedule.Mode == "" || schedule.Mode == trafficScheduleModeAlways {
+		return nil, nil
+	}
+
+	model := &TrafficRuleScheduleResourceModel{
+		EndTime:   types.StringNull(),
+		StartTime: types.StringNull(),
+	}
+
+	if !schedule.TimeAllDay {
+		model.EndTime = utils.EmptyStringNull(&schedule.TimeRangeEnd)
+		model.StartTime = utils.EmptyStringNull(&schedule.TimeRangeStart)
+	}
+
+	days := schedule.RepeatOnDays
+	if schedule.Mode == trafficScheduleModeEveryDay {
+		days = nil
+	}
+
+	var diags diag.Diagnostics
+	model.Days, diags = utils.StringSetValue(ctx, days)
+
+	return model, diags
+}
+
+func trafficDomainsSchema() schema.Attribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "The domains to match when `matching_target` is `DOMAIN`.",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+}
+
+func trafficIPAddressesSchema() schema.Attribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "The IPv4 or IPv6 addresses and subnets to match when `matching_target` is `IP`.",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(trafficIPAddressValidator{}),
+		},
+	}
+}
+
+func trafficRegionsSchema() schema.Attribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "The ISO 3166-1 alpha-2 country codes to match when `matching_target` is `REGION`.",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.RegexMatches(trafficRegionRegexp, "must be an upper case ISO 3166-1 alpha-2 country code")),
+		},
+	}
+}
+
+func trafficTargetClientMACsSchema() schema.Attribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "The clients the rule applies to. When neither this nor `target_network_ids` is set it " +
+			"applies to all clients.",
+		ElementType: customtype.MacType{},
+		Optional:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ConflictsWith(path.MatchRoot("target_network_ids")),
+		},
+	}
+}
+
+func trafficTargetNetworkIDsSchema() schema.Attribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "The networks whose clients the rule applies to.",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+}
+
+// trafficIPAddressValidator validates a value is an IP address or a subnet in CIDR notation.
+type trafficIPAddressValidator struct{}
+
+func (v trafficIPAddressValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v trafficIPAddressValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be an IP address or a subnet in CIDR notation"
+}
+
+func (v trafficIPAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, ok := parseTrafficIPAddress(req.ConfigValue.ValueString()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// parseTrafficIPAddress returns the IP version, `v4` or `v6`, of an address or subnet.
+func parseTrafficIPAddress(value string) (string, bool) {
+	var addr netip.Addr
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return "", false
+		}
+
+		addr = prefix.Addr()
+	} else {
+		var err error
+		if addr, err = netip.ParseAddr(value); err != nil {
+			return "", false
+		}
+	}
+
+	if addr.Is4() {
+		return "v4", true
+	}
+
+	return "v6", true
+}
+
+func toTrafficDomains(ctx context.Context, set types.Set) ([]trafficDomain, diag.Diagnostics) {
+	var values []string
+	diags := utils.SetValueStrings(ctx, set, &values)
+
+	domains := make([]trafficDomain, 0, len(values))
+	for _, value := range values {
+		domains = append(domains, trafficDomain{
+			Domain:     value,
+			PortRanges: make([]json.RawMessage, 0),
+			Ports:      make([]int64, 0),
+		})
+	}
+
+	return domains, diags
+}
+
+func newTrafficDomainsValue(ctx context.Context, domains []trafficDomain) (types.Set, diag.Diagnostics) {
+	values := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		values = append(values, domain.Domain)
+	}
+
+	return utils.StringSetValue(ctx, values)
+}
+
+func toTrafficIPAddresses(ctx context.Context, set types.Set) ([]trafficIPAddress, diag.Diagnostics) {
+	var values []string
+	diags := utils.SetValueStrings(ctx, set, &values)
+
+	addresses := make([]trafficIPAddress, 0, len(values))
+	for _, value := range values {
+		version, _ := parseTrafficIPAddress(value)
+		addresses = append(addresses, trafficIPAddress{
+			IPOrSubnet: value,
+			IPVersion:  version,
+			PortRanges: make([]json.RawMessage, 0),
+			Ports:      make([]int64, 0),
+		})
+	}
+
+	return addresses, diags
+}
+
+func newTrafficIPAddressesValue(ctx context.Context, addresses []trafficIPAddress) (types.Set, diag.Diagnostics) {
+	values := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		values = append(values, address.IPOrSubnet)
+	}
+
+	return utils.StringSetValue(ctx, values)
+}
+
+func toTrafficTargetDevices(ctx context.Context, clientMACs, networkIDs types.Set) ([]trafficTargetDevice, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var macs, networks []string
+	diags.Append(utils.SetValueStrings(ctx, clientMACs, &macs)...)
+	diags.Append(utils.SetValueStrings(ctx, networkIDs, &networks)...)
+
+	if len(macs) == 0 && len(networks) == 0 {
+		return []trafficTargetDevice{{Type: trafficTargetDeviceTypeAllClients}}, diags
+	}
+
+	devices := make([]trafficTargetDevice, 0, len(macs)+len(networks))
+	for _, mac := range macs {
+		devices = append(devices, trafficTargetDevice{ClientMAC: mac, Type: trafficTargetDeviceTypeClient})
+	}
+
+	for _, network := range networks {
+		devices = append(devices, trafficTargetDevice{NetworkID: network, Type: trafficTargetDeviceTypeNetwork})
+	}
+
+	return devices, diags
+}
+
+func newTrafficTargetDevicesValues(ctx context.Context, devices []trafficTargetDevice) (types.Set, types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var macs, networks []attr.Value
+	for _, device := range devices {
+		switch device.Type {
+		case trafficTargetDeviceTypeClient:
+			macs = append(macs, customtype.NewMacValue(device.ClientMAC))
+		case trafficTargetDeviceTypeNetwork:
+			networks = append(networks, types.StringValue(device.NetworkID))
+		}
+	}
+
+	clientMACs := types.SetNull(customtype.MacType{})
+	if len(macs) > 0 {
+		var d diag.Diagnostics
+		clientMACs, d = types.SetValue(customtype.MacType{}, macs)
+		diags.Append(d...)
+	}
+
+	networkIDs := types.SetNull(types.StringType)
+	if len(networks) > 0 {
+		var d diag.Diagnostics
+		networkIDs, d = types.SetValue(types.StringType, networks)
+		diags.Append(d...)
+	}
+
+	return clientMACs, networkIDs, diags
+}
+
+// setValueInt64s reads the elements of a set in to target. Null and unknown sets are left empty.
+func setValueInt64s(ctx context.Context, set types.Set, target *[]int64) diag.Diagnostics {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	return set.ElementsAs(ctx, target, false)
+}
+
+// int64SetValue returns a set of the values, or a null set when there are none.
+func int64SetValue(ctx context.Context, values []int64) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.SetNull(types.Int64Type), nil
+	}
+
+	return types.SetValueFrom(ctx, types.Int64Type, values)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccTrafficRuleResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTrafficRuleConfig("BLOCK", "DOMAIN", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccTrafficRuleConfig("SPEED_LIMIT", "INTERNET", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccTrafficRuleConfig("BLOCK", "IP", `ip_addresses = ["192.0.2.300"]`),
+				ExpectError: regexp.MustCompile(`Invalid IP Address`),
+			},
+			{
+				Config:      testAccTrafficRuleConfig("BLOCK", "REGION", `regions = ["gb"]`),
+				ExpectError: regexp.MustCompile(`ISO 3166-1 alpha-2 country code`),
+			},
+			{
+				Config: testAccTrafficRuleConfig("BLOCK", "INTERNET", `
+  schedule = {
+    start_time = "22:00"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccTrafficRuleResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTrafficRuleConfig("BLOCK", "DOMAIN", `domains = ["example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "description", "Test Rule"),
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "action", "BLOCK"),
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "matching_target", "DOMAIN"),
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "domains.#", "1"),
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "enabled", "true"),
+					resource.TestCheckNoResourceAttr("unifi_traffic_rule.test", "schedule"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_traffic_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTrafficRuleConfig("SPEED_LIMIT", "IP", `
+  ip_addresses = ["192.0.2.10", "2001:db8::/64"]
+
+  bandwidth_limit = {
+    download_kbps = 10000
+    upload_kbps   = 2000
+  }
+
+  schedule = {
+    days       = ["sat", "sun"]
+    start_time = "08:00"
+    end_time   = "20:00"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "action", "SPEED_LIMIT"),
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "bandwidth_limit.download_kbps", "10000"),
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "schedule.days.#", "2"),
+					resource.TestCheckResourceAttr("unifi_traffic_rule.test", "schedule.start_time", "08:00"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrafficRuleConfig(action, matchingTarget, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_traffic_rule" "test" {
+  description     = "Test Rule"
+  action          = %q
+  matching_target = %q
+  %s
+}
+`, action, matchingTarget, settings)
+}
@@ -0,0 +1,321 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"strings"
+)
+
+// userGroupRateUnlimited is the rate the controller uses for a user group without a limit.
+const userGroupRateUnlimited = -1
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &UserGroupResource{}
+	_ resource.ResourceWithImportState = &UserGroupResource{}
+
+	defaultUserGroupResourceModel = UserGroupResourceModel{}
+)
+
+func NewUserGroupResource() resource.Resource {
+	return &UserGroupResource{}
+}
+
+// UserGroupResource defines the resource implementation.
+type UserGroupResource struct {
+	client *unifiClient
+}
+
+func (r *UserGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_group"
+}
+
+func (r *UserGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultUserGroupResourceModel.schema()
+}
+
+func (r *UserGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	group := &unifi.UserGroup{}
+	data.toUnifiUserGroup(group)
+
+	group, err := r.client.CreateUserGroup(ctx, site, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user group, got error: %s", err))
+		return
+	}
+
+	data = newUserGroupResourceModel(group, site, data)
+
+	tflog.Trace(ctx, "User group created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	group, err := r.client.GetUserGroup(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user group, got error: %s", err))
+		return
+	}
+
+	data = newUserGroupResourceModel(group, site, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	group, err := r.client.GetUserGroup(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user group, got error: %s", err))
+		return
+	}
+
+	data.toUnifiUserGroup(group)
+
+	group, err = r.client.UpdateUserGroup(ctx, site, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user group, got error: %s", err))
+		return
+	}
+
+	data = newUserGroupResourceModel(group, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// The controller refuses to delete a group that is in use with an error that doesn't say what is using it, so look
+	// for the references first.
+	references, err := r.client.userGroupReferences(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check user group references, got error: %s", err))
+		return
+	}
+
+	if len(references) > 0 {
+		resp.Diagnostics.AddError(
+			"User Group In Use",
+			fmt.Sprintf("The user group %q can't be deleted as it is still used by:\n\n  - %s\n\nMove these to a "+
+				"different user group first.", data.Name.ValueString(), strings.Join(references, "\n  - ")),
+		)
+
+		return
+	}
+
+	err = r.client.DeleteUserGroup(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user group, got error: %s", err))
+		return
+	}
+}
+
+func (r *UserGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type UserGroupResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	DownloadRate types.Int32  `tfsdk:"download_rate_kbps"`
+	Name         types.String `tfsdk:"name"`
+	Site         types.String `tfsdk:"site"`
+	UploadRate   types.Int32  `tfsdk:"upload_rate_kbps"`
+}
+
+func (m *UserGroupResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi user group, which limits the bandwidth of the clients and WLANs it is " +
+			"assigned to.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi user group identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"download_rate_kbps": schema.Int32Attribute{
+				MarkdownDescription: "The maximum download rate of each client in kbps. When not set the rate is " +
+					"unlimited.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(2, 100000),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the user group belongs to. Setting this overrides the default site set " +
+					"in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"upload_rate_kbps": schema.Int32Attribute{
+				MarkdownDescription: "The maximum upload rate of each client in kbps. When not set the rate is " +
+					"unlimited.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(2, 100000),
+				},
+			},
+		},
+	}
+}
+
+func (m *UserGroupResourceModel) toUnifiUserGroup(group *unifi.UserGroup) {
+	group.Name = m.Name.ValueStringPointer()
+
+	group.QOSRateMaxDown = utils.IntPtr(userGroupRateUnlimited)
+	if !m.DownloadRate.IsNull() {
+		group.QOSRateMaxDown = utils.IntPtrValue(m.DownloadRate.ValueInt32Pointer())
+	}
+
+	group.QOSRateMaxUp = utils.IntPtr(userGroupRateUnlimited)
+	if !m.UploadRate.IsNull() {
+		group.QOSRateMaxUp = utils.IntPtrValue(m.UploadRate.ValueInt32Pointer())
+	}
+}
+
+func newUserGroupResourceModel(group *unifi.UserGroup, site string, model UserGroupResourceModel) UserGroupResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(group.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(group.SiteID)
+
+	// Configurable Values
+	model.Name = types.StringPointerValue(group.Name)
+
+	model.DownloadRate = types.Int32Null()
+	if group.QOSRateMaxDown != nil && *group.QOSRateMaxDown != userGroupRateUnlimited {
+		model.DownloadRate = types.Int32PointerValue(utils.Int32PtrValue(group.QOSRateMaxDown))
+	}
+
+	model.UploadRate = types.Int32Null()
+	if group.QOSRateMaxUp != nil && *group.QOSRateMaxUp != userGroupRateUnlimited {
+		model.UploadRate = types.Int32PointerValue(utils.Int32PtrValue(group.QOSRateMaxUp))
+	}
+
+	return model
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccUserGroupResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserGroupConfig(`download_rate_kbps = 1`),
+				ExpectError: regexp.MustCompile(`Attribute download_rate_kbps value must be between 2 and 100000`),
+			},
+		},
+	})
+}
+
+func TestAccUserGroupResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserGroupConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_user_group.test", "name", "Test Group"),
+					resource.TestCheckNoResourceAttr("unifi_user_group.test", "download_rate_kbps"),
+					resource.TestCheckNoResourceAttr("unifi_user_group.test", "upload_rate_kbps"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_user_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccUserGroupConfig(`
+  download_rate_kbps = 20000
+  upload_rate_kbps   = 5000
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_user_group.test", "download_rate_kbps", "20000"),
+					resource.TestCheckResourceAttr("unifi_user_group.test", "upload_rate_kbps", "5000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserGroupResource_InUse(t *testing.T) {
+	config := testAccUserGroupConfig("") + `
+resource "unifi_client" "test" {
+  mac               = "00:11:22:33:44:66"
+  name              = "Test Client"
+  forget_on_destroy = true
+  user_group_id     = unifi_user_group.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("unifi_client.test", "user_group_id", "unifi_user_group.test", "id"),
+				),
+			},
+			// Removing the group while the client still uses it. The client keeps the group as user_group_id is computed.
+			{
+				Config: `
+provider "unifi" {}
+resource "unifi_client" "test" {
+  mac               = "00:11:22:33:44:66"
+  name              = "Test Client"
+  forget_on_destroy = true
+}
+`,
+				ExpectError: regexp.MustCompile(`User Group In Use`),
+			},
+			// Restore the reference so the client is destroyed before the group.
+			{
+				Config: config,
+			},
+		},
+	})
+}
+
+func testAccUserGroupConfig(settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_user_group" "test" {
+  name = "Test Group"
+  %s
+}
+`, settings)
+}
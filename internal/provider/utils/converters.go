@@ -1,9 +1,62 @@
 package utils
 
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/netip"
+)
+
+// AddrValue returns the netip.Addr for an IPv4Address. The bool is false when the value is not known.
+func AddrValue(v iptypes.IPv4Address) (netip.Addr, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return netip.Addr{}, false
+	}
+
+	addr, diags := v.ValueIPv4Address()
+	if diags.HasError() {
+		return netip.Addr{}, false
+	}
+
+	return addr, true
+}
+
+// AddressListValue returns a list of IPv4 addresses from the numbered address fields used by the Unifi API, e.g.
+// `dhcpd_dns_1`. Empty values are skipped.
+func AddressListValue(ctx context.Context, addresses ...string) (types.List, diag.Diagnostics) {
+	var values []string
+	for _, address := range addresses {
+		if address != "" {
+			values = append(values, address)
+		}
+	}
+
+	return types.ListValueFrom(ctx, iptypes.IPv4AddressType{}, values)
+}
+
 func BoolPtr(v bool) *bool {
 	return &v
 }
 
+// EmptyStringNull returns a null string when the value is not set or empty.
+func EmptyStringNull(v *string) types.String {
+	if v == nil || *v == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*v)
+}
+
+// IndexString returns the value at index i, or an empty string when the slice is too short.
+func IndexString(values []string, i int) string {
+	if i >= len(values) {
+		return ""
+	}
+
+	return values[i]
+}
+
 func Int32PtrValue(val *int) *int32 {
 	if val == nil {
 		return nil
@@ -13,6 +66,29 @@ func Int32PtrValue(val *int) *int32 {
 	return &i
 }
 
+// Int32ToInt64Ptr converts a types.Int32 in to an *int64 for the controller, which is nil when v is null.
+func Int32ToInt64Ptr(v types.Int32) *int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	i := int64(v.ValueInt32())
+	return &i
+}
+
+// Int64PtrToInt32 converts an *int64 from the controller in to a types.Int32, which is null when v is.
+func Int64PtrToInt32(v *int64) types.Int32 {
+	if v == nil {
+		return types.Int32Null()
+	}
+
+	return types.Int32Value(int32(*v))
+}
+
+func IntPtr(v int) *int {
+	return &v
+}
+
 func IntPtrValue(val *int32) *int {
 	if val == nil {
 		return nil
@@ -22,6 +98,54 @@ func IntPtrValue(val *int32) *int {
 	return &i
 }
 
+// LastAddr returns the last, i.e. broadcast, address of an IPv4 prefix.
+func LastAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().As4()
+	hostBits := 32 - prefix.Bits()
+	for i := 3; i >= 0 && hostBits > 0; i-- {
+		bits := min(hostBits, 8)
+		b[i] |= byte(1<<bits - 1)
+		hostBits -= bits
+	}
+
+	return netip.AddrFrom4(b)
+}
+
+// ListValueStrings reads the elements of a list in to target. Null and unknown lists are left empty.
+func ListValueStrings(ctx context.Context, list types.List, target *[]string) diag.Diagnostics {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	return list.ElementsAs(ctx, target, false)
+}
+
+// SetValueStrings reads the elements of a set in to target. Null and unknown sets are left empty.
+func SetValueStrings(ctx context.Context, set types.Set, target *[]string) diag.Diagnostics {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	return set.ElementsAs(ctx, target, false)
+}
+
 func StringPtr(val string) *string {
 	return &val
 }
+
+// StringSetValue returns a set of the values, or a null set when there are none.
+func StringSetValue(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+func StringValue(val *string) string {
+	if val == nil {
+		return ""
+	}
+
+	return *val
+}
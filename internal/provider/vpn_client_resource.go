@@ -0,0 +1,671 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"strings"
+)
+
+const (
+	networkPurposeVPNClient = "vpn-client"
+
+	vpnClientTypeOpenVPN   = "openvpn"
+	vpnClientTypeWireGuard = "wireguard"
+
+	vpnTypeOpenVPNClient   = "openvpn-client"
+	vpnTypeWireGuardClient = "wireguard-client"
+
+	wireguardClientModeFile   = "file"
+	wireguardClientModeManual = "manual"
+
+	vpnConnectionStatusConnected    = "connected"
+	vpnConnectionStatusDisconnected = "disconnected"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &VPNClientResource{}
+	_ resource.ResourceWithImportState    = &VPNClientResource{}
+	_ resource.ResourceWithValidateConfig = &VPNClientResource{}
+
+	defaultVPNClientOpenVPNResourceModel   = VPNClientOpenVPNResourceModel{}
+	defaultVPNClientResourceModel          = VPNClientResourceModel{}
+	defaultVPNClientWireGuardResourceModel = VPNClientWireGuardResourceModel{}
+
+	// vpnClientTypes maps the type of the resource to the VPN type used by the controller.
+	vpnClientTypes = map[string]string{
+		vpnClientTypeOpenVPN:   vpnTypeOpenVPNClient,
+		vpnClientTypeWireGuard: vpnTypeWireGuardClient,
+	}
+)
+
+func NewVPNClientResource() resource.Resource {
+	return &VPNClientResource{}
+}
+
+// VPNClientResource defines the resource implementation.
+type VPNClientResource struct {
+	client *unifiClient
+}
+
+func (r *VPNClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_client"
+}
+
+func (r *VPNClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultVPNClientResourceModel.schema()
+}
+
+func (r *VPNClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VPNClientResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VPNClientResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+func (r *VPNClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VPNClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network := &unifi.Network{
+		Enabled: true,
+		Purpose: utils.StringPtr(networkPurposeVPNClient),
+	}
+
+	data.toUnifiNetwork(network)
+
+	network, err := r.client.CreateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create VPN client, got error: %s", err))
+		return
+	}
+
+	status, diags := r.status(ctx, site, *network.ID)
+	resp.Diagnostics.Append(diags...)
+
+	data = newVPNClientResourceModel(network, status, site, data)
+
+	tflog.Trace(ctx, "VPN client created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VPNClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VPN client, got error: %s", err))
+		return
+	}
+
+	if network.Purpose == nil || *network.Purpose != networkPurposeVPNClient {
+		resp.Diagnostics.AddError(
+			"Invalid Network Purpose",
+			fmt.Sprintf("Network %s is not a VPN client.", data.ID.ValueString()),
+		)
+
+		return
+	}
+
+	status, diags := r.status(ctx, site, data.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	data = newVPNClientResourceModel(network, status, site, data)
+
+	// Warn about tunnels that are down so they stand out in plans, rather than only being visible in the state.
+	if data.Enabled.ValueBool() && !data.Status.IsNull() && data.Status.ValueString() != vpnConnectionStatusConnected {
+		resp.Diagnostics.AddWarning(
+			"VPN Client Not Connected",
+			fmt.Sprintf("The VPN client %q is %s.", data.Name.ValueString(), data.Status.ValueString()),
+		)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VPNClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current network so settings that aren't managed by the resource are left untouched.
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VPN client, got error: %s", err))
+		return
+	}
+
+	data.toUnifiNetwork(network)
+
+	network, err = r.client.UpdateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update VPN client, got error: %s", err))
+		return
+	}
+
+	status, diags := r.status(ctx, site, data.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	data = newVPNClientResourceModel(network, status, site, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VPNClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteNetwork(ctx, site, data.ID.ValueString(), data.Name.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete VPN client, got error: %s", err))
+		return
+	}
+}
+
+func (r *VPNClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// status returns the connection state of the tunnel. Tunnels the controller doesn't list a connection for are
+// disconnected. The status is null for controllers that don't report the state of connections.
+func (r *VPNClientResource) status(ctx context.Context, site, id string) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	connections, err := r.client.listVPNConnection(ctx, site)
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return types.StringNull(), diags
+		}
+
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read VPN connections, got error: %s", err))
+		return types.StringNull(), diags
+	}
+
+	for _, connection := range connections {
+		if connection.ID == id {
+			return types.StringValue(strings.ToLower(connection.Status)), diags
+		}
+	}
+
+	return types.StringValue(vpnConnectionStatusDisconnected), diags
+}
+
+type VPNClientResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	Status types.String `tfsdk:"status"`
+
+	// Configurable Values
+	Enabled   types.Bool                       `tfsdk:"enabled"`
+	Name      types.String                     `tfsdk:"name"`
+	OpenVPN   *VPNClientOpenVPNResourceModel   `tfsdk:"openvpn"`
+	Site      types.String                     `tfsdk:"site"`
+	Type      types.String                     `tfsdk:"type"`
+	WireGuard *VPNClientWireGuardResourceModel `tfsdk:"wireguard"`
+}
+
+func (m *VPNClientResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "An outbound VPN tunnel from the gateway to a VPN provider or another gateway. The `id` " +
+			"can be used as the `network_id` of a `unifi_traffic_route` to send traffic through the tunnel.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi network identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The connection state of the tunnel when it was last read, e.g. `connected` or " +
+					"`disconnected`. Not set when the controller doesn't report the state of connections.",
+				Computed: true,
+			},
+
+			// Configurable values
+			"enabled": schema.BoolAttribute{
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"openvpn": defaultVPNClientOpenVPNResourceModel.schema(),
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the VPN client belongs to. Setting this overrides the default site set " +
+					"in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of VPN. One of `openvpn` or `wireguard`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(vpnClientTypeOpenVPN, vpnClientTypeWireGuard),
+					customvalidator.StringValueWithPaths(vpnClientTypeOpenVPN, path.MatchRoot("openvpn")),
+					customvalidator.StringValueWithPaths(vpnClientTypeWireGuard, path.MatchRoot("wireguard")),
+					customvalidator.StringValueConflictsWithPaths(vpnClientTypeOpenVPN, path.MatchRoot("wireguard")),
+					customvalidator.StringValueConflictsWithPaths(vpnClientTypeWireGuard, path.MatchRoot("openvpn")),
+				},
+			},
+			"wireguard": defaultVPNClientWireGuardResourceModel.schema(),
+		},
+	}
+}
+
+// validate checks the WireGuard keys, as the other settings are covered by the schema.
+func (m *VPNClientResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.WireGuard == nil {
+		return diags
+	}
+
+	p := path.Root("wireguard")
+	diags.Append(validateWireGuardKey(p.AtName("peer_public_key"), m.WireGuard.PeerPublicKey)...)
+	diags.Append(validateWireGuardKey(p.AtName("preshared_key"), m.WireGuard.PresharedKey)...)
+	diags.Append(validateWireGuardKey(p.AtName("private_key"), m.WireGuard.PrivateKey)...)
+
+	return diags
+}
+
+func (m *VPNClientResourceModel) toUnifiNetwork(network *unifi.Network) {
+	network.Enabled = m.Enabled.ValueBool()
+	network.Name = m.Name.ValueStringPointer()
+	network.VPNType = utils.StringPtr(vpnClientTypes[m.Type.ValueString()])
+
+	m.OpenVPN.toUnifiNetwork(network, m.Name.ValueString())
+	m.WireGuard.toUnifiNetwork(network, m.Name.ValueString())
+}
+
+func newVPNClientResourceModel(network *unifi.Network, status types.String, site string, model VPNClientResourceModel) VPNClientResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(network.ID)
+	model.Site = types.StringValue(site)
+	model.Status = status
+
+	// Configurable Values
+	model.Enabled = types.BoolValue(network.Enabled)
+	model.Name = types.StringPointerValue(network.Name)
+
+	model.Type = types.StringNull()
+	for t, vpnType := range vpnClientTypes {
+		if network.VPNType != nil && *network.VPNType == vpnType {
+			model.Type = types.StringValue(t)
+		}
+	}
+
+	model.OpenVPN = newVPNClientOpenVPNResourceModel(network, model.OpenVPN)
+	model.WireGuard = newVPNClientWireGuardResourceModel(network, model.WireGuard)
+
+	return model
+}
+
+type VPNClientOpenVPNResourceModel struct {
+	Configuration types.String `tfsdk:"configuration"`
+	Password      types.String `tfsdk:"password"`
+	Username      types.String `tfsdk:"username"`
+}
+
+func (m *VPNClientOpenVPNResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The settings of an `openvpn` client. Required when `type` is `openvpn`.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "The contents of the OpenVPN configuration file, as supplied by the VPN provider.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password to authenticate with, when the VPN provider requires one.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("username")),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username to authenticate with, when the VPN provider requires one.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("password")),
+				},
+			},
+		},
+	}
+}
+
+func (m *VPNClientOpenVPNResourceModel) toUnifiNetwork(network *unifi.Network, name string) {
+	if m == nil {
+		return
+	}
+
+	network.OpenVPNConfiguration = m.Configuration.ValueStringPointer()
+	network.OpenVPNConfigurationFilename = utils.StringPtr(name + ".ovpn")
+	network.OpenVPNUsername = utils.StringPtr(m.Username.ValueString())
+	network.XOpenVPNPassword = utils.StringPtr(m.Password.ValueString())
+}
+
+func newVPNClientOpenVPNResourceModel(network *unifi.Network, model *VPNClientOpenVPNResourceModel) *VPNClientOpenVPNResourceModel {
+	if network.VPNType == nil || *network.VPNType != vpnTypeOpenVPNClient {
+		return nil
+	}
+
+	if model == nil {
+		model = &VPNClientOpenVPNResourceModel{}
+	}
+
+	model.Username = utils.EmptyStringNull(network.OpenVPNUsername)
+
+	// The configuration and password aren't always returned, so keep the configured values when they're missing.
+	if network.OpenVPNConfiguration != nil && *network.OpenVPNConfiguration != "" || model.Configuration.IsNull() {
+		model.Configuration = types.StringPointerValue(network.OpenVPNConfiguration)
+	}
+
+	if network.XOpenVPNPassword != nil && *network.XOpenVPNPassword != "" || model.Password.IsNull() {
+		model.Password = utils.EmptyStringNull(network.XOpenVPNPassword)
+	}
+
+	return model
+}
+
+type VPNClientWireGuardResourceModel struct {
+	// Computed Values
+	PublicKey types.String `tfsdk:"public_key"`
+
+	// Configurable Values
+	Address       cidrtypes.IPv4Prefix `tfsdk:"address"`
+	Configuration types.String         `tfsdk:"configuration"`
+	PeerHost      types.String         `tfsdk:"peer_host"`
+	PeerPort      types.Int32          `tfsdk:"peer_port"`
+	PeerPublicKey types.String         `tfsdk:"peer_public_key"`
+	PresharedKey  types.String         `tfsdk:"preshared_key"`
+	PrivateKey    types.String         `tfsdk:"private_key"`
+}
+
+func (m *VPNClientWireGuardResourceModel) schema() schema.Attribute {
+	conflictsWithConfiguration := stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("configuration"))
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The settings of a `wireguard` client. Required when `type` is `wireguard`. Either " +
+			"`configuration` or the settings of the tunnel must be set.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The public key of the gateway, to add to the peer. Only set when `private_key` " +
+					"is set.",
+				Computed: true,
+			},
+
+			// Configurable values
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The address and prefix length of the gateway in the tunnel, e.g. " +
+					"`10.64.0.2/32`. Required when `configuration` is not set.",
+				CustomType: cidrtypes.IPv4PrefixType{},
+				Optional:   true,
+				Validators: []validator.String{
+					conflictsWithConfiguration,
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("private_key")),
+				},
+			},
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "The contents of the WireGuard configuration file, as supplied by the VPN " +
+					"provider.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("private_key")),
+				},
+			},
+			"peer_host": schema.StringAttribute{
+				MarkdownDescription: "The hostname or address of the peer. Required when `configuration` is not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					conflictsWithConfiguration,
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("private_key")),
+					stringvalidator.RegexMatches(dnsNameRegexp, "must be a hostname or IPv4 address"),
+				},
+			},
+			"peer_port": schema.Int32Attribute{
+				MarkdownDescription: "The port the peer listens on. Default: `51820` when `configuration` is not set",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+					int32validator.ConflictsWith(path.MatchRelative().AtParent().AtName("configuration")),
+				},
+			},
+			"peer_public_key": schema.StringAttribute{
+				MarkdownDescription: "The public key of the peer. Required when `configuration` is not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					conflictsWithConfiguration,
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("private_key")),
+				},
+			},
+			"preshared_key": schema.StringAttribute{
+				MarkdownDescription: "The pre-shared key of the peer, when it uses one.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					conflictsWithConfiguration,
+				},
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The private key of the gateway. Required when `configuration` is not set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(
+						path.MatchRelative().AtParent().AtName("address"),
+						path.MatchRelative().AtParent().AtName("peer_host"),
+						path.MatchRelative().AtParent().AtName("peer_public_key"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (m *VPNClientWireGuardResourceModel) toUnifiNetwork(network *unifi.Network, name string) {
+	if m == nil {
+		return
+	}
+
+	if !m.Configuration.IsNull() {
+		network.WireguardClientMode = utils.StringPtr(wireguardClientModeFile)
+
+		// The controller expects the configuration file to be base64 encoded, as it is when uploaded.
+		network.WireguardClientConfigurationFile = utils.StringPtr(base64.StdEncoding.EncodeToString([]byte(m.Configuration.ValueString())))
+		network.WireguardClientConfigurationFilename = utils.StringPtr(name + ".conf")
+
+		return
+	}
+
+	network.WireguardClientMode = utils.StringPtr(wireguardClientModeManual)
+	network.IPSubnet = m.Address.ValueStringPointer()
+	network.WireguardClientPeerIP = m.PeerHost.ValueStringPointer()
+	network.WireguardClientPeerPublicKey = m.PeerPublicKey.ValueStringPointer()
+	network.XWireguardPrivateKey = m.PrivateKey.ValueStringPointer()
+
+	network.WireguardClientPeerPort = utils.IntPtr(51820)
+	if !m.PeerPort.IsNull() && !m.PeerPort.IsUnknown() {
+		network.WireguardClientPeerPort = utils.IntPtrValue(m.PeerPort.ValueInt32Pointer())
+	}
+
+	network.WireguardClientPresharedKeyEnabled = !m.PresharedKey.IsNull()
+	network.WireguardClientPresharedKey = utils.StringPtr(m.PresharedKey.ValueString())
+}
+
+func newVPNClientWireGuardResourceModel(network *unifi.Network, model *VPNClientWireGuardResourceModel) *VPNClientWireGuardResourceModel {
+	if network.VPNType == nil || *network.VPNType != vpnTypeWireGuardClient {
+		return nil
+	}
+
+	if model == nil {
+		model = &VPNClientWireGuardResourceModel{}
+	}
+
+	if network.WireguardClientMode != nil && *network.WireguardClientMode == wireguardClientModeFile {
+		// The configuration isn't always returned, so keep the configured value when it's missing.
+		if network.WireguardClientConfigurationFile != nil && *network.WireguardClientConfigurationFile != "" {
+			if configuration, err := base64.StdEncoding.DecodeString(*network.WireguardClientConfigurationFile); err == nil {
+				model.Configuration = types.StringValue(string(configuration))
+			}
+		}
+
+		// The settings of the tunnel come from the configuration file.
+		model.Address = cidrtypes.NewIPv4PrefixNull()
+		model.PeerHost = types.StringNull()
+		model.PeerPort = types.Int32Null()
+		model.PeerPublicKey = types.StringNull()
+		model.PresharedKey = types.StringNull()
+		model.PrivateKey = types.StringNull()
+		model.PublicKey = types.StringNull()
+
+		return model
+	}
+
+	model.Configuration = types.StringNull()
+	model.Address = cidrtypes.NewIPv4PrefixPointerValue(network.IPSubnet)
+	model.PeerHost = types.StringPointerValue(network.WireguardClientPeerIP)
+	model.PeerPort = types.Int32PointerValue(utils.Int32PtrValue(network.WireguardClientPeerPort))
+	model.PeerPublicKey = types.StringPointerValue(network.WireguardClientPeerPublicKey)
+
+	// The keys aren't always returned, so keep the configured values when they're missing.
+	if !network.WireguardClientPresharedKeyEnabled {
+		model.PresharedKey = types.StringNull()
+	} else if network.WireguardClientPresharedKey != nil && *network.WireguardClientPresharedKey != "" {
+		model.PresharedKey = types.StringValue(*network.WireguardClientPresharedKey)
+	}
+
+	if network.XWireguardPrivateKey != nil && *network.XWireguardPrivateKey != "" || model.PrivateKey.IsNull() {
+		model.PrivateKey = utils.EmptyStringNull(network.XWireguardPrivateKey)
+	}
+
+	model.PublicKey = types.StringNull()
+	if publicKey, err := wireguardPublicKey(model.PrivateKey.ValueString()); err == nil && !model.PrivateKey.IsNull() {
+		model.PublicKey = types.StringValue(publicKey)
+	}
+
+	return model
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccVPNClientResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPNClientConfig("wireguard", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccVPNClientConfig("wireguard", `
+  wireguard = {
+    address         = "10.64.0.2/32"
+    peer_host       = "vpn.example.com"
+    peer_public_key = "not-a-key"
+    private_key     = "GL2Ghb4dCyltmBO0T8R5nPeuvzMBT5wi9oUuWALnH04="
+  }
+`),
+				ExpectError: regexp.MustCompile(`Invalid WireGuard Key`),
+			},
+			{
+				Config: testAccVPNClientConfig("wireguard", `
+  wireguard = {
+    configuration = "[Interface]"
+    peer_host     = "vpn.example.com"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccVPNClientResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVPNClientConfig("wireguard", `
+  wireguard = {
+    address         = "10.64.0.2/32"
+    peer_host       = "vpn.example.com"
+    peer_public_key = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+    private_key     = "GL2Ghb4dCyltmBO0T8R5nPeuvzMBT5wi9oUuWALnH04="
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_vpn_client.test", "wireguard.peer_port", "51820"),
+					resource.TestCheckResourceAttrSet("unifi_vpn_client.test", "wireguard.public_key"),
+					resource.TestCheckResourceAttrSet("unifi_vpn_client.test", "status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "unifi_vpn_client.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wireguard.preshared_key", "wireguard.private_key", "wireguard.public_key"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccVPNClientConfig("wireguard", `
+  enabled = false
+
+  wireguard = {
+    address         = "10.64.0.2/32"
+    peer_host       = "vpn.example.com"
+    peer_port       = 51821
+    peer_public_key = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+    private_key     = "GL2Ghb4dCyltmBO0T8R5nPeuvzMBT5wi9oUuWALnH04="
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_vpn_client.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("unifi_vpn_client.test", "wireguard.peer_port", "51821"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPNClientConfig(vpnType, settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_vpn_client" "test" {
+  name = "Test VPN Client"
+  type = %q
+  %s
+}
+`, vpnType, settings)
+}
@@ -0,0 +1,347 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"regexp"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &VPNServerL2TPResource{}
+	_ resource.ResourceWithImportState = &VPNServerL2TPResource{}
+
+	defaultVPNServerL2TPResourceModel = VPNServerL2TPResourceModel{}
+)
+
+func NewVPNServerL2TPResource() resource.Resource {
+	return &VPNServerL2TPResource{}
+}
+
+// VPNServerL2TPResource defines the resource implementation.
+type VPNServerL2TPResource struct {
+	client *unifiClient
+}
+
+func (r *VPNServerL2TPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_server_l2tp"
+}
+
+func (r *VPNServerL2TPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultVPNServerL2TPResourceModel.schema()
+}
+
+func (r *VPNServerL2TPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VPNServerL2TPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VPNServerL2TPResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network := &unifi.Network{
+		Enabled: true,
+		Purpose: utils.StringPtr(networkPurposeRemoteUserVPN),
+		VPNType: utils.StringPtr(vpnTypeL2TPServer),
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := r.client.CreateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create L2TP VPN server, got error: %s", err))
+		return
+	}
+
+	data, diags := newVPNServerL2TPResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "L2TP VPN server created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNServerL2TPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VPNServerL2TPResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read L2TP VPN server, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkVPNServerNetwork(network, vpnTypeL2TPServer, "L2TP")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := newVPNServerL2TPResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNServerL2TPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VPNServerL2TPResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current network so settings that aren't managed by the resource are left untouched.
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read L2TP VPN server, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err = r.client.UpdateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update L2TP VPN server, got error: %s", err))
+		return
+	}
+
+	data, diags := newVPNServerL2TPResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNServerL2TPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VPNServerL2TPResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteNetwork(ctx, site, data.ID.ValueString(), data.Name.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete L2TP VPN server, got error: %s", err))
+		return
+	}
+}
+
+func (r *VPNServerL2TPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type VPNServerL2TPResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	AllowWeakCiphers types.Bool           `tfsdk:"allow_weak_ciphers"`
+	DNSServers       types.List           `tfsdk:"dns_servers"`
+	Interface        types.String         `tfsdk:"interface"`
+	Name             types.String         `tfsdk:"name"`
+	PreSharedKey     types.String         `tfsdk:"pre_shared_key"`
+	RADIUSProfileID  types.String         `tfsdk:"radius_profile_id"`
+	RequireMSCHAPv2  types.Bool           `tfsdk:"require_mschapv2"`
+	Site             types.String         `tfsdk:"site"`
+	Subnet           cidrtypes.IPv4Prefix `tfsdk:"subnet"`
+}
+
+func (m *VPNServerL2TPResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "An L2TP over IPsec VPN server on the gateway for remote access. Users are authenticated " +
+			"with the accounts of a RADIUS profile, see `unifi_radius_account`.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi network identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"allow_weak_ciphers": schema.BoolAttribute{
+				MarkdownDescription: "Allow ciphers that are no longer considered secure, for older clients. " +
+					"Default: `false`",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"dns_servers": vpnServerDNSServersSchema(),
+			"interface":   vpnServerInterfaceSchema(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"pre_shared_key": schema.StringAttribute{
+				MarkdownDescription: "The IPsec pre-shared key clients use to connect.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^"' ]+$`), "must not contain quotes or spaces"),
+				},
+			},
+			"radius_profile_id": schema.StringAttribute{
+				MarkdownDescription: "The RADIUS profile users are authenticated with. When not set the default " +
+					"profile of the site is used.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"require_mschapv2": schema.BoolAttribute{
+				MarkdownDescription: "Only allow clients that authenticate with MS-CHAP v2. Default: `true`",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the VPN server belongs to. Setting this overrides the default site set " +
+					"in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet": vpnServerSubnetSchema(),
+		},
+	}
+}
+
+func (m *VPNServerL2TPResourceModel) toUnifiNetwork(ctx context.Context, network *unifi.Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	network.Name = m.Name.ValueStringPointer()
+	network.IPSubnet = m.Subnet.ValueStringPointer()
+	network.L2TpAllowWeakCiphers = m.AllowWeakCiphers.ValueBool()
+	network.L2TpInterface = m.Interface.ValueStringPointer()
+	network.RequireMschapv2 = m.RequireMSCHAPv2.ValueBool()
+	network.XIPSecPreSharedKey = m.PreSharedKey.ValueStringPointer()
+
+	// Leave the profile to the controller when it isn't known.
+	if !m.RADIUSProfileID.IsUnknown() {
+		network.RADIUSProfileID = m.RADIUSProfileID.ValueString()
+	}
+
+	diags.Append(vpnServerDNSServersToUnifiNetwork(ctx, m.DNSServers, network)...)
+
+	return diags
+}
+
+func newVPNServerL2TPResourceModel(ctx context.Context, network *unifi.Network, site string, model VPNServerL2TPResourceModel) (VPNServerL2TPResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(network.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.AllowWeakCiphers = types.BoolValue(network.L2TpAllowWeakCiphers)
+	model.Interface = types.StringPointerValue(network.L2TpInterface)
+	model.Name = types.StringPointerValue(network.Name)
+	model.RADIUSProfileID = types.StringValue(network.RADIUSProfileID)
+	model.RequireMSCHAPv2 = types.BoolValue(network.RequireMschapv2)
+	model.Subnet = cidrtypes.NewIPv4PrefixPointerValue(network.IPSubnet)
+
+	// The pre-shared key isn't always returned, so keep the configured value when it's missing.
+	if network.XIPSecPreSharedKey != nil && *network.XIPSecPreSharedKey != "" || model.PreSharedKey.IsNull() {
+		model.PreSharedKey = types.StringPointerValue(network.XIPSecPreSharedKey)
+	}
+
+	var d diag.Diagnostics
+	model.DNSServers, d = newVPNServerDNSServersValue(ctx, network)
+	diags.Append(d...)
+
+	return model, diags
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccVPNServerL2TPResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVPNServerL2TPConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_vpn_server_l2tp.test", "require_mschapv2", "true"),
+					resource.TestCheckResourceAttr("unifi_vpn_server_l2tp.test", "allow_weak_ciphers", "false"),
+					resource.TestCheckResourceAttrSet("unifi_vpn_server_l2tp.test", "radius_profile_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "unifi_vpn_server_l2tp.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pre_shared_key"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccVPNServerL2TPConfig(`
+  allow_weak_ciphers = true
+  dns_servers        = ["1.1.1.1", "1.0.0.1"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_vpn_server_l2tp.test", "allow_weak_ciphers", "true"),
+					resource.TestCheckResourceAttr("unifi_vpn_server_l2tp.test", "dns_servers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPNServerL2TPConfig(settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_vpn_server_l2tp" "test" {
+  name           = "Test L2TP"
+  pre_shared_key = "not-a-real-key"
+  subnet         = "192.168.5.1/24"
+  %s
+}
+`, settings)
+}
@@ -0,0 +1,361 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+)
+
+const (
+	openVPNCipherAES256CBC = "AES_256_CBC"
+	openVPNCipherAES256GCM = "AES_256_GCM"
+	openVPNCipherBFCBC     = "BF_CBC"
+
+	openVPNProtocolTCP = "TCP"
+	openVPNProtocolUDP = "UDP"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &VPNServerOpenVPNResource{}
+	_ resource.ResourceWithImportState = &VPNServerOpenVPNResource{}
+
+	defaultVPNServerOpenVPNResourceModel = VPNServerOpenVPNResourceModel{}
+)
+
+func NewVPNServerOpenVPNResource() resource.Resource {
+	return &VPNServerOpenVPNResource{}
+}
+
+// VPNServerOpenVPNResource defines the resource implementation.
+type VPNServerOpenVPNResource struct {
+	client *unifiClient
+}
+
+func (r *VPNServerOpenVPNResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_server_openvpn"
+}
+
+func (r *VPNServerOpenVPNResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultVPNServerOpenVPNResourceModel.schema()
+}
+
+func (r *VPNServerOpenVPNResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VPNServerOpenVPNResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VPNServerOpenVPNResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network := &unifi.Network{
+		Enabled: true,
+		Purpose: utils.StringPtr(networkPurposeRemoteUserVPN),
+		VPNType: utils.StringPtr(vpnTypeOpenVPNServer),
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := r.client.CreateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OpenVPN VPN server, got error: %s", err))
+		return
+	}
+
+	data, diags := newVPNServerOpenVPNResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "OpenVPN VPN server created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNServerOpenVPNResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VPNServerOpenVPNResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OpenVPN VPN server, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkVPNServerNetwork(network, vpnTypeOpenVPNServer, "OpenVPN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := newVPNServerOpenVPNResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNServerOpenVPNResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VPNServerOpenVPNResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current network so settings that aren't managed by the resource are left untouched.
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OpenVPN VPN server, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err = r.client.UpdateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OpenVPN VPN server, got error: %s", err))
+		return
+	}
+
+	data, diags := newVPNServerOpenVPNResourceModel(ctx, network, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNServerOpenVPNResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VPNServerOpenVPNResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteNetwork(ctx, site, data.ID.ValueString(), data.Name.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OpenVPN VPN server, got error: %s", err))
+		return
+	}
+}
+
+func (r *VPNServerOpenVPNResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type VPNServerOpenVPNResourceModel struct {
+	// Computed Values
+	ID types.String `tfsdk:"id"`
+
+	// Configurable Values
+	ClientEndpoint   types.String         `tfsdk:"client_endpoint"`
+	DNSServers       types.List           `tfsdk:"dns_servers"`
+	EncryptionCipher types.String         `tfsdk:"encryption_cipher"`
+	Interface        types.String         `tfsdk:"interface"`
+	Name             types.String         `tfsdk:"name"`
+	Port             types.Int32          `tfsdk:"port"`
+	Protocol         types.String         `tfsdk:"protocol"`
+	Site             types.String         `tfsdk:"site"`
+	Subnet           cidrtypes.IPv4Prefix `tfsdk:"subnet"`
+}
+
+func (m *VPNServerOpenVPNResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "An OpenVPN server on the gateway for remote access. The client configuration file is " +
+			"downloaded from the controller.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi network identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"client_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The hostname or IP address clients connect to, used in the client configuration " +
+					"file. When not set the address of the WAN interface is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dnsNameRegexp, "must be a hostname or IPv4 address"),
+				},
+			},
+			"dns_servers": vpnServerDNSServersSchema(),
+			"encryption_cipher": schema.StringAttribute{
+				MarkdownDescription: "The cipher used to encrypt traffic. One of `AES_256_GCM`, `AES_256_CBC` or " +
+					"`BF_CBC`. Default: `AES_256_GCM`",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(openVPNCipherAES256GCM),
+				Validators: []validator.String{
+					stringvalidator.OneOf(openVPNCipherAES256GCM, openVPNCipherAES256CBC, openVPNCipherBFCBC),
+				},
+			},
+			"interface": vpnServerInterfaceSchema(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The port the server listens on. Default: `1194`",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(1194),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol the server listens on. One of `UDP` or `TCP`. Default: `UDP`",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(openVPNProtocolUDP),
+				Validators: []validator.String{
+					stringvalidator.OneOf(openVPNProtocolUDP, openVPNProtocolTCP),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the VPN server belongs to. Setting this overrides the default site set " +
+					"in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet": vpnServerSubnetSchema(),
+		},
+	}
+}
+
+func (m *VPNServerOpenVPNResourceModel) toUnifiNetwork(ctx context.Context, network *unifi.Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	network.Name = m.Name.ValueStringPointer()
+	network.IPSubnet = m.Subnet.ValueStringPointer()
+	network.LocalPort = utils.IntPtrValue(m.Port.ValueInt32Pointer())
+	network.OpenVPNEncryptionCipher = m.EncryptionCipher.ValueStringPointer()
+	network.OpenVPNInterface = m.Interface.ValueStringPointer()
+	network.VPNProtocol = m.Protocol.ValueStringPointer()
+
+	network.VPNClientConfigurationRemoteIPOverrideEnabled = !m.ClientEndpoint.IsNull()
+	network.VPNClientConfigurationRemoteIPOverride = utils.StringPtr(m.ClientEndpoint.ValueString())
+
+	diags.Append(vpnServerDNSServersToUnifiNetwork(ctx, m.DNSServers, network)...)
+
+	return diags
+}
+
+func newVPNServerOpenVPNResourceModel(ctx context.Context, network *unifi.Network, site string, model VPNServerOpenVPNResourceModel) (VPNServerOpenVPNResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(network.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.EncryptionCipher = types.StringPointerValue(network.OpenVPNEncryptionCipher)
+	model.Interface = types.StringPointerValue(network.OpenVPNInterface)
+	model.Name = types.StringPointerValue(network.Name)
+	model.Port = types.Int32PointerValue(utils.Int32PtrValue(network.LocalPort))
+	model.Protocol = types.StringPointerValue(network.VPNProtocol)
+	model.Subnet = cidrtypes.NewIPv4PrefixPointerValue(network.IPSubnet)
+
+	model.ClientEndpoint = types.StringNull()
+	if network.VPNClientConfigurationRemoteIPOverrideEnabled {
+		model.ClientEndpoint = utils.EmptyStringNull(network.VPNClientConfigurationRemoteIPOverride)
+	}
+
+	var d diag.Diagnostics
+	model.DNSServers, d = newVPNServerDNSServersValue(ctx, network)
+	diags.Append(d...)
+
+	return model, diags
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccVPNServerOpenVPNResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVPNServerOpenVPNConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_vpn_server_openvpn.test", "port", "1194"),
+					resource.TestCheckResourceAttr("unifi_vpn_server_openvpn.test", "protocol", "UDP"),
+					resource.TestCheckResourceAttr("unifi_vpn_server_openvpn.test", "encryption_cipher", "AES_256_GCM"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_vpn_server_openvpn.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccVPNServerOpenVPNConfig(`
+  client_endpoint = "vpn.example.com"
+  port            = 443
+  protocol        = "TCP"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_vpn_server_openvpn.test", "client_endpoint", "vpn.example.com"),
+					resource.TestCheckResourceAttr("unifi_vpn_server_openvpn.test", "port", "443"),
+					resource.TestCheckResourceAttr("unifi_vpn_server_openvpn.test", "protocol", "TCP"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPNServerOpenVPNConfig(settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_vpn_server_openvpn" "test" {
+  name   = "Test OpenVPN"
+  subnet = "192.168.4.1/24"
+  %s
+}
+`, settings)
+}
@@ -0,0 +1,866 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"net/netip"
+	"strings"
+)
+
+const (
+	networkPurposeRemoteUserVPN = "remote-user-vpn"
+
+	vpnServerInterfaceWAN  = "wan"
+	vpnServerInterfaceWAN2 = "wan2"
+
+	vpnTypeL2TPServer      = "l2tp-server"
+	vpnTypeOpenVPNServer   = "openvpn-server"
+	vpnTypeWireGuardServer = "wireguard-server"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                   = &VPNServerWireGuardResource{}
+	_ resource.ResourceWithImportState    = &VPNServerWireGuardResource{}
+	_ resource.ResourceWithModifyPlan     = &VPNServerWireGuardResource{}
+	_ resource.ResourceWithValidateConfig = &VPNServerWireGuardResource{}
+
+	defaultVPNServerWireGuardPeerResourceModel = VPNServerWireGuardPeerResourceModel{}
+	defaultVPNServerWireGuardResourceModel     = VPNServerWireGuardResourceModel{}
+)
+
+func NewVPNServerWireGuardResource() resource.Resource {
+	return &VPNServerWireGuardResource{}
+}
+
+// VPNServerWireGuardResource defines the resource implementation.
+type VPNServerWireGuardResource struct {
+	client *unifiClient
+}
+
+func (r *VPNServerWireGuardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_server_wireguard"
+}
+
+func (r *VPNServerWireGuardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultVPNServerWireGuardResourceModel.schema()
+}
+
+func (r *VPNServerWireGuardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VPNServerWireGuardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VPNServerWireGuardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.validate()...)
+}
+
+// ModifyPlan works out the keys and client configurations that can be known before apply. Keys generated by the
+// provider are kept for as long as the key isn't supplied in the configuration.
+func (r *VPNServerWireGuardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan VPNServerWireGuardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *VPNServerWireGuardResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &VPNServerWireGuardResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.PublicKey = types.StringUnknown()
+	if !plan.PrivateKey.IsUnknown() && !plan.PrivateKey.IsNull() {
+		if publicKey, err := wireguardPublicKey(plan.PrivateKey.ValueString()); err == nil {
+			plan.PublicKey = types.StringValue(publicKey)
+		}
+	}
+
+	for name, peer := range plan.Peers {
+		if config.Peers[name].PublicKey.IsNull() {
+			// The provider generates the key pair, so reuse the one it generated previously.
+			peer.PrivateKey, peer.PublicKey = types.StringUnknown(), types.StringUnknown()
+			if state != nil {
+				if prior, ok := state.Peers[name]; ok && !prior.PrivateKey.IsNull() {
+					peer.PrivateKey, peer.PublicKey = prior.PrivateKey, prior.PublicKey
+				}
+			}
+		} else {
+			peer.PrivateKey = types.StringNull()
+		}
+
+		peer.ClientConfiguration = plan.clientConfiguration(peer)
+		plan.Peers[name] = peer
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *VPNServerWireGuardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VPNServerWireGuardResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	resp.Diagnostics.Append(data.generateKeys()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network := &unifi.Network{
+		Enabled: true,
+		Purpose: utils.StringPtr(networkPurposeRemoteUserVPN),
+		VPNType: utils.StringPtr(vpnTypeWireGuardServer),
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := r.client.CreateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create WireGuard VPN server, got error: %s", err))
+		return
+	}
+
+	peers, err := r.syncPeers(ctx, site, *network.ID, data.Peers)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create WireGuard VPN server peers, got error: %s", err))
+		return
+	}
+
+	data, diags := newVPNServerWireGuardResourceModel(ctx, network, peers, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "WireGuard VPN server created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNServerWireGuardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VPNServerWireGuardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WireGuard VPN server, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkVPNServerNetwork(network, vpnTypeWireGuardServer, "WireGuard")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peers, err := r.client.listWireGuardPeer(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WireGuard VPN server peers, got error: %s", err))
+		return
+	}
+
+	data, diags := newVPNServerWireGuardResourceModel(ctx, network, peers, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNServerWireGuardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VPNServerWireGuardResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	resp.Diagnostics.Append(data.generateKeys()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Start from the current network so settings that aren't managed by the resource are left untouched.
+	network, err := r.client.GetNetwork(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WireGuard VPN server, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiNetwork(ctx, network)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err = r.client.UpdateNetwork(ctx, site, network)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update WireGuard VPN server, got error: %s", err))
+		return
+	}
+
+	peers, err := r.syncPeers(ctx, site, *network.ID, data.Peers)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update WireGuard VPN server peers, got error: %s", err))
+		return
+	}
+
+	data, diags := newVPNServerWireGuardResourceModel(ctx, network, peers, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPNServerWireGuardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VPNServerWireGuardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteNetwork(ctx, site, data.ID.ValueString(), data.Name.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete WireGuard VPN server, got error: %s", err))
+		return
+	}
+}
+
+func (r *VPNServerWireGuardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// syncPeers makes the peers of the server match those planned, matching existing peers by name. Peers are deleted
+// first so their addresses can be reused by the peers that are created.
+func (r *VPNServerWireGuardResource) syncPeers(ctx context.Context, site, networkID string, planned map[string]VPNServerWireGuardPeerResourceModel) ([]wireguardPeer, error) {
+	existing, err := r.client.listWireGuardPeer(ctx, site, networkID)
+	if err != nil {
+		return nil, err
+	}
+
+	existingByName := make(map[string]wireguardPeer, len(existing))
+	var deleted []string
+	for _, peer := range existing {
+		if _, ok := planned[peer.Name]; !ok && peer.ID != nil {
+			deleted = append(deleted, *peer.ID)
+			continue
+		}
+
+		existingByName[peer.Name] = peer
+	}
+
+	var created, updated []wireguardPeer
+	for name, model := range planned {
+		peer := model.toWireGuardPeer(name, networkID)
+		current, ok := existingByName[name]
+		switch {
+		case !ok:
+			created = append(created, peer)
+		case current.InterfaceIP != peer.InterfaceIP || current.PresharedKey != peer.PresharedKey ||
+			current.PublicKey != peer.PublicKey:
+			peer.ID = current.ID
+			updated = append(updated, peer)
+		}
+	}
+
+	if len(deleted) > 0 {
+		if err = r.client.deleteWireGuardPeers(ctx, site, networkID, deleted); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updated) > 0 {
+		if _, err = r.client.updateWireGuardPeers(ctx, site, networkID, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(created) > 0 {
+		if _, err = r.client.createWireGuardPeers(ctx, site, networkID, created); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.client.listWireGuardPeer(ctx, site, networkID)
+}
+
+type VPNServerWireGuardResourceModel struct {
+	// Computed Values
+	ID        types.String `tfsdk:"id"`
+	PublicKey types.String `tfsdk:"public_key"`
+
+	// Configurable Values
+	ClientEndpoint types.String                                   `tfsdk:"client_endpoint"`
+	DNSServers     types.List                                     `tfsdk:"dns_servers"`
+	Interface      types.String                                   `tfsdk:"interface"`
+	Name           types.String                                   `tfsdk:"name"`
+	Peers          map[string]VPNServerWireGuardPeerResourceModel `tfsdk:"peers"`
+	Port           types.Int32                                    `tfsdk:"port"`
+	PrivateKey     types.String                                   `tfsdk:"private_key"`
+	Site           types.String                                   `tfsdk:"site"`
+	Subnet         cidrtypes.IPv4Prefix                           `tfsdk:"subnet"`
+}
+
+func (m *VPNServerWireGuardResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A WireGuard VPN server on the gateway for remote access, along with the peers that can " +
+			"connect to it.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi network identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The public key of the server.",
+				Computed:            true,
+			},
+
+			// Configurable values
+			"client_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The hostname or IP address peers connect to, used in their client configuration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dnsNameRegexp, "must be a hostname or IPv4 address"),
+				},
+			},
+			"dns_servers": vpnServerDNSServersSchema(),
+			"interface":   vpnServerInterfaceSchema(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"peers": schema.MapNestedAttribute{
+				MarkdownDescription: "The peers that can connect to the server, keyed by name.",
+				NestedObject:        defaultVPNServerWireGuardPeerResourceModel.schema(),
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 128)),
+				},
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The UDP port the server listens on. Default: `51820`",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(51820),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The private key of the server. A key is generated when not set.",
+				Computed:            true,
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the VPN server belongs to. Setting this overrides the default site set " +
+					"in the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet": vpnServerSubnetSchema(),
+		},
+	}
+}
+
+// validate checks the keys are WireGuard keys and each peer has a unique address in the subnet of the server.
+func (m *VPNServerWireGuardResourceModel) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(validateWireGuardKey(path.Root("private_key"), m.PrivateKey)...)
+
+	prefix, prefixKnown := prefixValue(m.Subnet)
+	addresses := make(map[netip.Addr]string, len(m.Peers))
+	for name, peer := range m.Peers {
+		p := path.Root("peers").AtMapKey(name)
+		diags.Append(validateWireGuardKey(p.AtName("preshared_key"), peer.PresharedKey)...)
+		diags.Append(validateWireGuardKey(p.AtName("public_key"), peer.PublicKey)...)
+
+		addr, ok := utils.AddrValue(peer.InterfaceIP)
+		if !ok {
+			continue
+		}
+
+		if prefixKnown && (!prefix.Contains(addr) || addr == prefix.Addr()) {
+			diags.AddAttributeError(
+				p.AtName("interface_ip"),
+				"Invalid Peer Address",
+				fmt.Sprintf("The address of peer %q must be in the subnet %s and not the address of the server.", name, prefix),
+			)
+		}
+
+		if other, ok := addresses[addr]; ok {
+			diags.AddAttributeError(
+				p.AtName("interface_ip"),
+				"Invalid Peer Address",
+				fmt.Sprintf("Peers %q and %q have the same address %s.", other, name, addr),
+			)
+		}
+		addresses[addr] = name
+	}
+
+	return diags
+}
+
+// generateKeys generates the keys that aren't known yet, which are those the provider is responsible for.
+func (m *VPNServerWireGuardResourceModel) generateKeys() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.PrivateKey.IsUnknown() {
+		privateKey, publicKey, err := generateWireGuardKey()
+		if err != nil {
+			diags.AddError("Key Generation Error", fmt.Sprintf("Unable to generate a WireGuard key, got error: %s", err))
+			return diags
+		}
+
+		m.PrivateKey, m.PublicKey = types.StringValue(privateKey), types.StringValue(publicKey)
+	}
+
+	for name, peer := range m.Peers {
+		if peer.PublicKey.IsUnknown() {
+			privateKey, publicKey, err := generateWireGuardKey()
+			if err != nil {
+				diags.AddError("Key Generation Error", fmt.Sprintf("Unable to generate a WireGuard key, got error: %s", err))
+				return diags
+			}
+
+			peer.PrivateKey, peer.PublicKey = types.StringValue(privateKey), types.StringValue(publicKey)
+		}
+
+		m.Peers[name] = peer
+	}
+
+	return diags
+}
+
+// clientConfiguration renders the wg-quick configuration for a peer. It is unknown until everything it's built from
+// is known.
+func (m *VPNServerWireGuardResourceModel) clientConfiguration(peer VPNServerWireGuardPeerResourceModel) types.String {
+	for _, v := range []interface{ IsUnknown() bool }{
+		m.ClientEndpoint, m.DNSServers, m.Port, m.PublicKey, m.Subnet,
+		peer.InterfaceIP, peer.PresharedKey, peer.PrivateKey,
+	} {
+		if v.IsUnknown() {
+			return types.StringUnknown()
+		}
+	}
+
+	// Clients use the gateway for DNS unless other servers are handed out.
+	var dnsServers []string
+	for _, element := range m.DNSServers.Elements() {
+		if address, ok := element.(iptypes.IPv4Address); ok {
+			dnsServers = append(dnsServers, address.ValueString())
+		}
+	}
+
+	if prefix, ok := prefixValue(m.Subnet); ok && len(dnsServers) == 0 {
+		dnsServers = append(dnsServers, prefix.Addr().String())
+	}
+
+	var b strings.Builder
+	b.WriteString("[Interface]\n")
+	if peer.PrivateKey.IsNull() {
+		b.WriteString("# The key pair of this peer wasn't generated by Terraform, so add its private key.\n")
+		b.WriteString("PrivateKey = \n")
+	} else {
+		fmt.Fprintf(&b, "PrivateKey = %s\n", peer.PrivateKey.ValueString())
+	}
+	fmt.Fprintf(&b, "Address = %s/32\n", peer.InterfaceIP.ValueString())
+	if len(dnsServers) > 0 {
+		fmt.Fprintf(&b, "DNS = %s\n", strings.Join(dnsServers, ", "))
+	}
+
+	b.WriteString("\n[Peer]\n")
+	fmt.Fprintf(&b, "PublicKey = %s\n", m.PublicKey.ValueString())
+	if !peer.PresharedKey.IsNull() {
+		fmt.Fprintf(&b, "PresharedKey = %s\n", peer.PresharedKey.ValueString())
+	}
+	b.WriteString("AllowedIPs = 0.0.0.0/0\n")
+	fmt.Fprintf(&b, "Endpoint = %s:%d\n", m.ClientEndpoint.ValueString(), m.Port.ValueInt32())
+
+	return types.StringValue(b.String())
+}
+
+func (m *VPNServerWireGuardResourceModel) toUnifiNetwork(ctx context.Context, network *unifi.Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	network.Name = m.Name.ValueStringPointer()
+	network.IPSubnet = m.Subnet.ValueStringPointer()
+	network.LocalPort = utils.IntPtrValue(m.Port.ValueInt32Pointer())
+	network.WireguardInterface = m.Interface.ValueStringPointer()
+	network.WireguardPublicKey = m.PublicKey.ValueStringPointer()
+	network.XWireguardPrivateKey = m.PrivateKey.ValueStringPointer()
+
+	network.VPNClientConfigurationRemoteIPOverrideEnabled = true
+	network.VPNClientConfigurationRemoteIPOverride = m.ClientEndpoint.ValueStringPointer()
+
+	diags.Append(vpnServerDNSServersToUnifiNetwork(ctx, m.DNSServers, network)...)
+
+	return diags
+}
+
+func newVPNServerWireGuardResourceModel(ctx context.Context, network *unifi.Network, peers []wireguardPeer, site string, model VPNServerWireGuardResourceModel) (VPNServerWireGuardResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(network.ID)
+	model.Site = types.StringValue(site)
+
+	// Configurable Values
+	model.ClientEndpoint = types.StringPointerValue(network.VPNClientConfigurationRemoteIPOverride)
+	model.Interface = types.StringPointerValue(network.WireguardInterface)
+	model.Name = types.StringPointerValue(network.Name)
+	model.Port = types.Int32PointerValue(utils.Int32PtrValue(network.LocalPort))
+	model.Subnet = cidrtypes.NewIPv4PrefixPointerValue(network.IPSubnet)
+
+	// The private key isn't always returned, so keep the known value when it's missing.
+	if network.XWireguardPrivateKey != nil && *network.XWireguardPrivateKey != "" {
+		model.PrivateKey = types.StringValue(*network.XWireguardPrivateKey)
+	}
+
+	model.PublicKey = types.StringPointerValue(network.WireguardPublicKey)
+	if publicKey, err := wireguardPublicKey(model.PrivateKey.ValueString()); err == nil && !model.PrivateKey.IsNull() {
+		model.PublicKey = types.StringValue(publicKey)
+	}
+
+	var d diag.Diagnostics
+	model.DNSServers, d = newVPNServerDNSServersValue(ctx, network)
+	diags.Append(d...)
+
+	var models map[string]VPNServerWireGuardPeerResourceModel
+	if len(peers) > 0 {
+		models = make(map[string]VPNServerWireGuardPeerResourceModel, len(peers))
+	}
+
+	for _, peer := range peers {
+		models[peer.Name] = newVPNServerWireGuardPeerResourceModel(peer, model.Peers[peer.Name])
+	}
+
+	model.Peers = models
+	for name, peer := range model.Peers {
+		peer.ClientConfiguration = model.clientConfiguration(peer)
+		model.Peers[name] = peer
+	}
+
+	return model, diags
+}
+
+type VPNServerWireGuardPeerResourceModel struct {
+	// Computed Values
+	ClientConfiguration types.String `tfsdk:"client_configuration"`
+	ID                  types.String `tfsdk:"id"`
+	PrivateKey          types.String `tfsdk:"private_key"`
+
+	// Configurable Values
+	InterfaceIP  iptypes.IPv4Address `tfsdk:"interface_ip"`
+	PresharedKey types.String        `tfsdk:"preshared_key"`
+	PublicKey    types.String        `tfsdk:"public_key"`
+}
+
+func (m *VPNServerWireGuardPeerResourceModel) schema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"client_configuration": schema.StringAttribute{
+				MarkdownDescription: "The wg-quick configuration for the peer, ready to import in to a WireGuard client.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi peer identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The private key of the peer when its key pair is generated by the provider.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+
+			// Configurable values
+			"interface_ip": schema.StringAttribute{
+				MarkdownDescription: "The address of the peer in the subnet of the server.",
+				CustomType:          iptypes.IPv4AddressType{},
+				Required:            true,
+			},
+			"preshared_key": schema.StringAttribute{
+				MarkdownDescription: "A pre-shared key for an additional layer of symmetric encryption.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The public key of the peer. When not set a key pair is generated, and the " +
+					"private key is included in `client_configuration`.",
+				Computed: true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (m *VPNServerWireGuardPeerResourceModel) toWireGuardPeer(name, networkID string) wireguardPeer {
+	return wireguardPeer{
+		InterfaceIP:  m.InterfaceIP.ValueString(),
+		Name:         name,
+		NetworkID:    networkID,
+		PresharedKey: m.PresharedKey.ValueString(),
+		PublicKey:    m.PublicKey.ValueString(),
+	}
+}
+
+func newVPNServerWireGuardPeerResourceModel(peer wireguardPeer, model VPNServerWireGuardPeerResourceModel) VPNServerWireGuardPeerResourceModel {
+	// Computed values
+	model.ID = types.StringPointerValue(peer.ID)
+
+	// Configurable Values
+	model.InterfaceIP = iptypes.NewIPv4AddressValue(peer.InterfaceIP)
+	model.PresharedKey = utils.EmptyStringNull(&peer.PresharedKey)
+
+	// A different public key means the key pair was changed outside of Terraform, so the private key no longer matches.
+	if model.PublicKey.ValueString() != peer.PublicKey {
+		model.PrivateKey = types.StringNull()
+	}
+	model.PublicKey = types.StringValue(peer.PublicKey)
+
+	if model.PrivateKey.IsUnknown() {
+		model.PrivateKey = types.StringNull()
+	}
+
+	return model
+}
+
+func vpnServerDNSServersSchema() schema.Attribute {
+	return schema.ListAttribute{
+		MarkdownDescription: "The DNS servers handed out to clients. When not set the gateway is used.",
+		ElementType:         iptypes.IPv4AddressType{},
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 4),
+		},
+	}
+}
+
+func vpnServerInterfaceSchema() schema.Attribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The WAN interface the server listens on. One of `wan` or `wan2`. Default: `wan`",
+		Computed:            true,
+		Optional:            true,
+		Default:             stringdefault.StaticString(vpnServerInterfaceWAN),
+		Validators: []validator.String{
+			stringvalidator.OneOf(vpnServerInterfaceWAN, vpnServerInterfaceWAN2),
+		},
+	}
+}
+
+func vpnServerSubnetSchema() schema.Attribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The gateway IP address and prefix length of the network clients are given addresses " +
+			"from, e.g. `192.168.3.1/24`.",
+		CustomType: cidrtypes.IPv4PrefixType{},
+		Required:   true,
+	}
+}
+
+func vpnServerDNSServersToUnifiNetwork(ctx context.Context, list types.List, network *unifi.Network) diag.Diagnostics {
+	var dnsServers []string
+	diags := utils.ListValueStrings(ctx, list, &dnsServers)
+
+	network.DHCPDDNSEnabled = len(dnsServers) > 0
+	network.DHCPDDNS1, network.DHCPDDNS2, network.DHCPDDNS3, network.DHCPDDNS4 = utils.IndexString(dnsServers, 0),
+		utils.IndexString(dnsServers, 1), utils.IndexString(dnsServers, 2), utils.IndexString(dnsServers, 3)
+
+	return diags
+}
+
+func newVPNServerDNSServersValue(ctx context.Context, network *unifi.Network) (types.List, diag.Diagnostics) {
+	if !network.DHCPDDNSEnabled {
+		return types.ListNull(iptypes.IPv4AddressType{}), nil
+	}
+
+	return utils.AddressListValue(ctx, network.DHCPDDNS1, network.DHCPDDNS2, network.DHCPDDNS3, network.DHCPDDNS4)
+}
+
+// checkVPNServerNetwork returns an error when a network isn't a remote access VPN server of the given type, which
+// means it's managed by another resource.
+func checkVPNServerNetwork(network *unifi.Network, vpnType, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if network.Purpose != nil && *network.Purpose == networkPurposeRemoteUserVPN && network.VPNType != nil &&
+		*network.VPNType == vpnType {
+		return diags
+	}
+
+	diags.AddError(
+		"Invalid Network Purpose",
+		fmt.Sprintf("Network %s is not a %s VPN server.", utils.StringValue(network.ID), name),
+	)
+
+	return diags
+}
+
+// prefixValue returns the netip.Prefix for an IPv4Prefix. The bool is false when the value is not known.
+func prefixValue(v cidrtypes.IPv4Prefix) (netip.Prefix, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return netip.Prefix{}, false
+	}
+
+	prefix, diags := v.ValueIPv4Prefix()
+	if diags.HasError() {
+		return netip.Prefix{}, false
+	}
+
+	return prefix, true
+}
+
+// validateWireGuardKey checks a key is a base64 encoded 32 byte key, as used for WireGuard private, public and
+// pre-shared keys.
+func validateWireGuardKey(p path.Path, v types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return diags
+	}
+
+	if key, err := base64.StdEncoding.DecodeString(v.ValueString()); err != nil || len(key) != 32 {
+		diags.AddAttributeError(p, "Invalid WireGuard Key", "The value must be a base64 encoded 32 byte key.")
+	}
+
+	return diags
+}
+
+// generateWireGuardKey generates a new key pair, returning the base64 encoded private and public keys.
+func generateWireGuardKey() (string, string, error) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key.Bytes()), base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
+
+// wireguardPublicKey returns the base64 encoded public key for a base64 encoded private key.
+func wireguardPublicKey(privateKey string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := ecdh.X25519().NewPrivateKey(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
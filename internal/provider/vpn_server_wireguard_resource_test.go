@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccVPNServerWireGuardResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPNServerWireGuardConfig(`private_key = "not-a-key"`, ""),
+				ExpectError: regexp.MustCompile(`Invalid WireGuard Key`),
+			},
+			{
+				Config: testAccVPNServerWireGuardConfig("", `
+    laptop = {
+      interface_ip = "192.168.99.2"
+    }
+`),
+				ExpectError: regexp.MustCompile(`Invalid Peer Address`),
+			},
+			{
+				Config: testAccVPNServerWireGuardConfig("", `
+    laptop = {
+      interface_ip = "192.168.3.2"
+    }
+    phone = {
+      interface_ip = "192.168.3.2"
+    }
+`),
+				ExpectError: regexp.MustCompile(`have the same address`),
+			},
+		},
+	})
+}
+
+func TestAccVPNServerWireGuardResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVPNServerWireGuardConfig("", `
+    laptop = {
+      interface_ip = "192.168.3.2"
+    }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_vpn_server_wireguard.test", "port", "51820"),
+					resource.TestCheckResourceAttr("unifi_vpn_server_wireguard.test", "interface", "wan"),
+					resource.TestCheckResourceAttrSet("unifi_vpn_server_wireguard.test", "public_key"),
+					resource.TestCheckResourceAttrSet("unifi_vpn_server_wireguard.test", "peers.laptop.public_key"),
+					resource.TestCheckResourceAttrSet("unifi_vpn_server_wireguard.test", "peers.laptop.private_key"),
+					resource.TestMatchResourceAttr("unifi_vpn_server_wireguard.test", "peers.laptop.client_configuration",
+						regexp.MustCompile(`Endpoint = vpn.example.com:51820`)),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "unifi_vpn_server_wireguard.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"peers.laptop.client_configuration", "peers.laptop.private_key"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccVPNServerWireGuardConfig(`
+  dns_servers = ["1.1.1.1"]
+  port        = 51821
+`, `
+    laptop = {
+      interface_ip = "192.168.3.2"
+    }
+    phone = {
+      interface_ip = "192.168.3.3"
+      public_key   = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+    }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_vpn_server_wireguard.test", "port", "51821"),
+					resource.TestCheckResourceAttr("unifi_vpn_server_wireguard.test", "peers.%", "2"),
+					resource.TestCheckNoResourceAttr("unifi_vpn_server_wireguard.test", "peers.phone.private_key"),
+					resource.TestMatchResourceAttr("unifi_vpn_server_wireguard.test", "peers.laptop.client_configuration",
+						regexp.MustCompile(`DNS = 1.1.1.1`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPNServerWireGuardConfig(settings, peers string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_vpn_server_wireguard" "test" {
+  name            = "Test WireGuard"
+  client_endpoint = "vpn.example.com"
+  subnet          = "192.168.3.1/24"
+  %s
+
+  peers = {
+    %s
+  }
+}
+`, settings, peers)
+}
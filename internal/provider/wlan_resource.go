@@ -0,0 +1,783 @@
+// Copyright (c) James Toyer
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jamestoyer/go-unifi/unifi"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customtype"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/customvalidator"
+	"github.com/jamestoyer/terraform-provider-unifi/internal/provider/utils"
+	"slices"
+)
+
+const (
+	wlanSecurityEnterprise = "enterprise"
+	wlanSecurityOpen       = "open"
+	wlanSecurityWPA2       = "wpa2"
+	wlanSecurityWPA2WPA3   = "wpa2-wpa3"
+	wlanSecurityWPA3       = "wpa3"
+
+	wlanBand2G = "2g"
+	wlanBand5G = "5g"
+	wlanBand6G = "6g"
+)
+
+var (
+	// Ensure provider defined types fully satisfy framework interfaces.
+	_ resource.Resource                = &WLANResource{}
+	_ resource.ResourceWithImportState = &WLANResource{}
+
+	defaultWLANMACFilterResourceModel        = WLANMACFilterResourceModel{}
+	defaultWLANMinimumDataRatesResourceModel = WLANMinimumDataRatesResourceModel{}
+	defaultWLANResourceModel                 = WLANResourceModel{}
+	defaultWLANScheduleResourceModel         = WLANScheduleResourceModel{}
+
+	wlanScheduleDays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+func NewWLANResource() resource.Resource {
+	return &WLANResource{}
+}
+
+// WLANResource defines the resource implementation.
+type WLANResource struct {
+	client *unifiClient
+}
+
+func (r *WLANResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wlan"
+}
+
+func (r *WLANResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = defaultWLANResourceModel.schema()
+}
+
+func (r *WLANResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WLANResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WLANResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Every WLAN must belong to a user group, so fall back to the site default like the controller UI does.
+	if data.UserGroupID.IsUnknown() || data.UserGroupID.IsNull() {
+		userGroupID, err := r.client.getDefaultUserGroupID(ctx, site)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the default user group, got error: %s", err))
+			return
+		}
+
+		data.UserGroupID = types.StringValue(userGroupID)
+	}
+
+	wlan := &unifi.WLAN{
+		DTIMMode:                 utils.StringPtr("default"),
+		Enabled:                  true,
+		GroupRekey:               utils.IntPtr(3600),
+		MinrateSettingPreference: utils.StringPtr("auto"),
+		SettingPreference:        utils.StringPtr("auto"),
+		WPAEnc:                   utils.StringPtr("ccmp"),
+	}
+
+	resp.Diagnostics.Append(data.toUnifiWLAN(ctx, wlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wlan, err := r.client.CreateWLAN(ctx, site, wlan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create WLAN, got error: %s", err))
+		return
+	}
+
+	data, diags := newWLANResourceModel(ctx, wlan, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "WLAN created")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WLANResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WLANResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	wlan, err := r.client.GetWLAN(ctx, site, data.ID.ValueString())
+	if err != nil {
+		var notFoundError *unifi.NotFoundError
+		if errors.As(err, &notFoundError) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WLAN, got error: %s", err))
+		return
+	}
+
+	data, diags := newWLANResourceModel(ctx, wlan, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WLANResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WLANResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	// Start from the current WLAN so settings that aren't managed by the resource are left untouched.
+	wlan, err := r.client.GetWLAN(ctx, site, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read WLAN, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.toUnifiWLAN(ctx, wlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wlan, err = r.client.UpdateWLAN(ctx, site, wlan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update WLAN, got error: %s", err))
+		return
+	}
+
+	data, diags := newWLANResourceModel(ctx, wlan, site, data)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WLANResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WLANResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site := r.client.site
+	if data.Site.ValueString() != "" {
+		site = data.Site.ValueString()
+	}
+
+	err := r.client.DeleteWLAN(ctx, site, data.ID.ValueString())
+	var notFoundError *unifi.NotFoundError
+	if err != nil && !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete WLAN, got error: %s", err))
+		return
+	}
+}
+
+func (r *WLANResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type WLANResourceModel struct {
+	// Computed Values
+	ID     types.String `tfsdk:"id"`
+	SiteID types.String `tfsdk:"site_id"`
+
+	// Configurable Values
+	APGroupIDs       types.Set                          `tfsdk:"ap_group_ids"`
+	Bands            types.Set                          `tfsdk:"bands"`
+	ClientIsolation  types.Bool                         `tfsdk:"client_isolation"`
+	FastRoaming      types.Bool                         `tfsdk:"fast_roaming"`
+	HideSSID         types.Bool                         `tfsdk:"hide_ssid"`
+	MACFilter        *WLANMACFilterResourceModel        `tfsdk:"mac_filter"`
+	MinimumDataRates *WLANMinimumDataRatesResourceModel `tfsdk:"minimum_data_rates"`
+	Name             types.String                       `tfsdk:"name"`
+	NetworkID        types.String                       `tfsdk:"network_id"`
+	Passphrase       types.String                       `tfsdk:"passphrase"`
+	RADIUSProfileID  types.String                       `tfsdk:"radius_profile_id"`
+	Schedule         []WLANScheduleResourceModel        `tfsdk:"schedule"`
+	Security         types.String                       `tfsdk:"security"`
+	Site             types.String                       `tfsdk:"site"`
+	UserGroupID      types.String                       `tfsdk:"user_group_id"`
+}
+
+func (m *WLANResourceModel) schema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "A Unifi wireless network.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed values
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Unifi WLAN identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				MarkdownDescription: "The Unifi internal ID of the site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Configurable values
+			"ap_group_ids": schema.SetAttribute{
+				MarkdownDescription: "The AP groups that broadcast the WLAN. When not set the WLAN is broadcast by all " +
+					"access points.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"bands": schema.SetAttribute{
+				MarkdownDescription: "The radio bands the WLAN is broadcast on. Any of `2g`, `5g` and `6g`. " +
+					"Default: `[\"2g\", \"5g\"]`",
+				ElementType: types.StringType,
+				Computed:    true,
+				Optional:    true,
+				Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue(wlanBand2G),
+					types.StringValue(wlanBand5G),
+				})),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(wlanBand2G, wlanBand5G, wlanBand6G)),
+				},
+			},
+			"client_isolation": schema.BoolAttribute{
+				MarkdownDescription: "When true, wireless clients on the WLAN cannot communicate with each other.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"fast_roaming": schema.BoolAttribute{
+				MarkdownDescription: "Enable 802.11r fast roaming between access points.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"hide_ssid": schema.BoolAttribute{
+				MarkdownDescription: "When true, the SSID is not broadcast.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"mac_filter":         defaultWLANMACFilterResourceModel.schema(),
+			"minimum_data_rates": defaultWLANMinimumDataRatesResourceModel.schema(),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The SSID of the WLAN.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the network wireless clients are connected to.",
+				Required:            true,
+			},
+			"passphrase": schema.StringAttribute{
+				MarkdownDescription: "The pre-shared key of the WLAN. Required for the `wpa2`, `wpa3` and `wpa2-wpa3` " +
+					"security modes.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 255),
+				},
+			},
+			"radius_profile_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the RADIUS profile used to authenticate clients. Required for the " +
+					"`enterprise` security mode.",
+				Optional: true,
+			},
+			"schedule": schema.ListNestedAttribute{
+				MarkdownDescription: "When set, the WLAN is only broadcast during these times.",
+				Optional:            true,
+				NestedObject:        defaultWLANScheduleResourceModel.schema(),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"security": schema.StringAttribute{
+				MarkdownDescription: "The security mode of the WLAN. One of `open`, `wpa2`, `wpa3`, `wpa2-wpa3` or " +
+					"`enterprise`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(wlanSecurityOpen, wlanSecurityWPA2, wlanSecurityWPA3, wlanSecurityWPA2WPA3,
+						wlanSecurityEnterprise),
+					customvalidator.StringValueWithPaths(wlanSecurityWPA2, path.MatchRoot("passphrase")),
+					customvalidator.StringValueWithPaths(wlanSecurityWPA3, path.MatchRoot("passphrase")),
+					customvalidator.StringValueWithPaths(wlanSecurityWPA2WPA3, path.MatchRoot("passphrase")),
+					customvalidator.StringValueWithPaths(wlanSecurityEnterprise, path.MatchRoot("radius_profile_id")),
+					customvalidator.StringValueConflictsWithPaths(wlanSecurityOpen,
+						path.MatchRoot("passphrase"),
+						path.MatchRoot("radius_profile_id"),
+					),
+					customvalidator.StringValueConflictsWithPaths(wlanSecurityEnterprise, path.MatchRoot("passphrase")),
+					customvalidator.StringValueConflictsWithPaths(wlanSecurityWPA2, path.MatchRoot("radius_profile_id")),
+					customvalidator.StringValueConflictsWithPaths(wlanSecurityWPA3, path.MatchRoot("radius_profile_id")),
+					customvalidator.StringValueConflictsWithPaths(wlanSecurityWPA2WPA3, path.MatchRoot("radius_profile_id")),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "The site the WLAN belongs to. Setting this overrides the default site set in " +
+					"the provider",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user group, i.e. bandwidth profile, applied to clients on the " +
+					"WLAN. Defaults to the default user group of the site.",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (m *WLANResourceModel) toUnifiWLAN(ctx context.Context, wlan *unifi.WLAN) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	wlan.FastRoamingEnabled = m.FastRoaming.ValueBool()
+	wlan.HideSSID = m.HideSSID.ValueBool()
+	wlan.L2Isolation = m.ClientIsolation.ValueBool()
+	wlan.Name = m.Name.ValueStringPointer()
+	wlan.NetworkID = m.NetworkID.ValueString()
+	wlan.RADIUSProfileID = m.RADIUSProfileID.ValueString()
+	wlan.UserGroupID = m.UserGroupID.ValueString()
+
+	// Security
+	wlan.WPA3Support = false
+	wlan.WPA3Transition = false
+	wlan.PMFMode = utils.StringPtr("disabled")
+	wlan.XPassphrase = m.Passphrase.ValueStringPointer()
+	switch m.Security.ValueString() {
+	case wlanSecurityOpen:
+		wlan.Security = utils.StringPtr("open")
+	case wlanSecurityWPA2:
+		wlan.Security = utils.StringPtr("wpapsk")
+		wlan.WPAMode = utils.StringPtr("wpa2")
+	case wlanSecurityWPA3:
+		wlan.Security = utils.StringPtr("wpapsk")
+		wlan.WPAMode = utils.StringPtr("wpa2")
+		wlan.WPA3Support = true
+		wlan.PMFMode = utils.StringPtr("required")
+	case wlanSecurityWPA2WPA3:
+		wlan.Security = utils.StringPtr("wpapsk")
+		wlan.WPAMode = utils.StringPtr("wpa2")
+		wlan.WPA3Support = true
+		wlan.WPA3Transition = true
+		wlan.PMFMode = utils.StringPtr("optional")
+	case wlanSecurityEnterprise:
+		wlan.Security = utils.StringPtr("wpaeap")
+		wlan.WPAMode = utils.StringPtr("wpa2")
+	}
+
+	wlan.WPA3FastRoaming = wlan.WPA3Support && wlan.FastRoamingEnabled
+
+	// Bands
+	var bands []string
+	diags.Append(m.Bands.ElementsAs(ctx, &bands, false)...)
+	wlan.WLANBands = &bands
+	wlan.WLANBand = utils.StringPtr("both")
+	if slices.Contains(bands, wlanBand2G) != slices.Contains(bands, wlanBand5G) {
+		wlan.WLANBand = utils.StringPtr(wlanBand5G)
+		if slices.Contains(bands, wlanBand2G) {
+			wlan.WLANBand = utils.StringPtr(wlanBand2G)
+		}
+	}
+
+	// AP groups
+	wlan.ApGroupMode = utils.StringPtr("all")
+	wlan.ApGroupIDs = &[]string{}
+	if !m.APGroupIDs.IsNull() {
+		var apGroupIDs []string
+		diags.Append(m.APGroupIDs.ElementsAs(ctx, &apGroupIDs, false)...)
+		wlan.ApGroupMode = utils.StringPtr("groups")
+		wlan.ApGroupIDs = &apGroupIDs
+	}
+
+	// Schedule
+	wlan.ScheduleEnabled = len(m.Schedule) > 0
+	wlan.ScheduleWithDuration = make([]unifi.WLANScheduleWithDuration, 0, len(m.Schedule))
+	for _, schedule := range m.Schedule {
+		s, d := schedule.toUnifiStruct(ctx)
+		diags.Append(d...)
+		wlan.ScheduleWithDuration = append(wlan.ScheduleWithDuration, s)
+	}
+
+	diags.Append(m.MACFilter.toUnifiWLAN(ctx, wlan)...)
+	m.MinimumDataRates.toUnifiWLAN(wlan)
+
+	return diags
+}
+
+func newWLANResourceModel(ctx context.Context, wlan *unifi.WLAN, site string, model WLANResourceModel) (WLANResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Computed values
+	model.ID = types.StringPointerValue(wlan.ID)
+	model.Site = types.StringValue(site)
+	model.SiteID = types.StringPointerValue(wlan.SiteID)
+
+	// Configurable Values
+	model.ClientIsolation = types.BoolValue(wlan.L2Isolation)
+	model.FastRoaming = types.BoolValue(wlan.FastRoamingEnabled)
+	model.HideSSID = types.BoolValue(wlan.HideSSID)
+	model.Name = types.StringPointerValue(wlan.Name)
+	model.NetworkID = types.StringValue(wlan.NetworkID)
+	model.UserGroupID = types.StringValue(wlan.UserGroupID)
+
+	model.Security = types.StringPointerValue(wlan.Security)
+	switch {
+	case wlan.Security == nil:
+	case *wlan.Security == "wpaeap":
+		model.Security = types.StringValue(wlanSecurityEnterprise)
+	case *wlan.Security == "wpapsk" && wlan.WPA3Support && wlan.WPA3Transition:
+		model.Security = types.StringValue(wlanSecurityWPA2WPA3)
+	case *wlan.Security == "wpapsk" && wlan.WPA3Support:
+		model.Security = types.StringValue(wlanSecurityWPA3)
+	case *wlan.Security == "wpapsk":
+		model.Security = types.StringValue(wlanSecurityWPA2)
+	}
+
+	model.RADIUSProfileID = types.StringNull()
+	if wlan.RADIUSProfileID != "" {
+		model.RADIUSProfileID = types.StringValue(wlan.RADIUSProfileID)
+	}
+
+	// The passphrase is only returned to admins with permission to see it, so keep the configured value otherwise.
+	if wlan.XPassphrase != nil && *wlan.XPassphrase != "" {
+		model.Passphrase = types.StringPointerValue(wlan.XPassphrase)
+	} else if model.Security.ValueString() == wlanSecurityOpen || model.Security.ValueString() == wlanSecurityEnterprise {
+		model.Passphrase = types.StringNull()
+	}
+
+	var bands []string
+	if wlan.WLANBands != nil {
+		bands = *wlan.WLANBands
+	} else if wlan.WLANBand != nil && *wlan.WLANBand == "both" {
+		bands = []string{wlanBand2G, wlanBand5G}
+	} else if wlan.WLANBand != nil {
+		bands = []string{*wlan.WLANBand}
+	}
+
+	var d diag.Diagnostics
+	model.Bands, d = types.SetValueFrom(ctx, types.StringType, bands)
+	diags.Append(d...)
+
+	model.APGroupIDs = types.SetNull(types.StringType)
+	if wlan.ApGroupMode != nil && *wlan.ApGroupMode == "groups" && wlan.ApGroupIDs != nil {
+		model.APGroupIDs, d = types.SetValueFrom(ctx, types.StringType, *wlan.ApGroupIDs)
+		diags.Append(d...)
+	}
+
+	model.Schedule = nil
+	if wlan.ScheduleEnabled && len(wlan.ScheduleWithDuration) > 0 {
+		model.Schedule = make([]WLANScheduleResourceModel, 0, len(wlan.ScheduleWithDuration))
+		for _, schedule := range wlan.ScheduleWithDuration {
+			s, d := newWLANScheduleResourceModel(ctx, schedule)
+			diags.Append(d...)
+			model.Schedule = append(model.Schedule, s)
+		}
+	}
+
+	model.MACFilter, d = newWLANMACFilterResourceModel(ctx, wlan)
+	diags.Append(d...)
+	model.MinimumDataRates = newWLANMinimumDataRatesResourceModel(wlan)
+
+	return model, diags
+}
+
+type WLANMACFilterResourceModel struct {
+	MACAddresses types.Set    `tfsdk:"mac_addresses"`
+	Policy       types.String `tfsdk:"policy"`
+}
+
+func (m *WLANMACFilterResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Restrict which clients can connect to the WLAN by MAC address.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"mac_addresses": schema.SetAttribute{
+				ElementType: customtype.MacType{},
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: "Whether the listed clients are the only ones allowed to connect (`allow`), or " +
+					"are blocked from connecting (`deny`).",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("allow", "deny"),
+				},
+			},
+		},
+	}
+}
+
+func (m *WLANMACFilterResourceModel) toUnifiWLAN(ctx context.Context, wlan *unifi.WLAN) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m == nil {
+		wlan.MACFilterEnabled = false
+		wlan.MACFilterList = &[]string{}
+		return diags
+	}
+
+	var macs []string
+	diags.Append(m.MACAddresses.ElementsAs(ctx, &macs, false)...)
+
+	wlan.MACFilterEnabled = true
+	wlan.MACFilterList = &macs
+	wlan.MACFilterPolicy = m.Policy.ValueStringPointer()
+
+	return diags
+}
+
+func newWLANMACFilterResourceModel(ctx context.Context, wlan *unifi.WLAN) (*WLANMACFilterResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !wlan.MACFilterEnabled {
+		return nil, diags
+	}
+
+	var macs []string
+	if wlan.MACFilterList != nil {
+		macs = *wlan.MACFilterList
+	}
+
+	macAddresses, diags := types.SetValueFrom(ctx, customtype.MacType{}, macs)
+
+	return &WLANMACFilterResourceModel{
+		MACAddresses: macAddresses,
+		Policy:       types.StringPointerValue(wlan.MACFilterPolicy),
+	}, diags
+}
+
+type WLANMinimumDataRatesResourceModel struct {
+	Rate2G types.Int32 `tfsdk:"rate_2g_kbps"`
+	Rate5G types.Int32 `tfsdk:"rate_5g_kbps"`
+}
+
+func (m *WLANMinimumDataRatesResourceModel) schema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Stop clients with a poor connection from slowing the WLAN for everyone else by " +
+			"setting the minimum data rate per band. When not set the controller manages the rates.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"rate_2g_kbps": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.OneOf(1000, 2000, 5500, 6000, 9000, 12000, 18000, 24000, 36000, 48000, 54000),
+					int32validator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("rate_5g_kbps")),
+				},
+			},
+			"rate_5g_kbps": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.OneOf(6000, 9000, 12000, 18000, 24000, 36000, 48000, 54000),
+				},
+			},
+		},
+	}
+}
+
+func (m *WLANMinimumDataRatesResourceModel) toUnifiWLAN(wlan *unifi.WLAN) {
+	if m == nil {
+		wlan.MinrateSettingPreference = utils.StringPtr("auto")
+		wlan.MinrateNgEnabled = false
+		wlan.MinrateNaEnabled = false
+		return
+	}
+
+	wlan.MinrateSettingPreference = utils.StringPtr("manual")
+	wlan.MinrateNgEnabled = !m.Rate2G.IsNull()
+	wlan.MinrateNgDataRateKbps = utils.IntPtrValue(m.Rate2G.ValueInt32Pointer())
+	wlan.MinrateNaEnabled = !m.Rate5G.IsNull()
+	wlan.MinrateNaDataRateKbps = utils.IntPtrValue(m.Rate5G.ValueInt32Pointer())
+}
+
+func newWLANMinimumDataRatesResourceModel(wlan *unifi.WLAN) *WLANMinimumDataRatesResourceModel {
+	if !wlan.MinrateNgEnabled && !wlan.MinrateNaEnabled {
+		return nil
+	}
+
+	model := &WLANMinimumDataRatesResourceModel{
+		Rate2G: types.Int32Null(),
+		Rate5G: types.Int32Null(),
+	}
+
+	if wlan.MinrateNgEnabled {
+		model.Rate2G = types.Int32PointerValue(utils.Int32PtrValue(wlan.MinrateNgDataRateKbps))
+	}
+
+	if wlan.MinrateNaEnabled {
+		model.Rate5G = types.Int32PointerValue(utils.Int32PtrValue(wlan.MinrateNaDataRateKbps))
+	}
+
+	return model
+}
+
+type WLANScheduleResourceModel struct {
+	Days            types.Set   `tfsdk:"days"`
+	DurationMinutes types.Int32 `tfsdk:"duration_minutes"`
+	StartHour       types.Int32 `tfsdk:"start_hour"`
+	StartMinute     types.Int32 `tfsdk:"start_minute"`
+}
+
+func (m *WLANScheduleResourceModel) schema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"days": schema.SetAttribute{
+				MarkdownDescription: "The days the block starts on. Any of `sun`, `mon`, `tue`, `wed`, `thu`, `fri` " +
+					"and `sat`.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(wlanScheduleDays...)),
+				},
+			},
+			"duration_minutes": schema.Int32Attribute{
+				MarkdownDescription: "How long the WLAN is broadcast for.",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 10080),
+				},
+			},
+			"start_hour": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 23),
+				},
+			},
+			"start_minute": schema.Int32Attribute{
+				Computed: true,
+				Optional: true,
+				Default:  int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.Between(0, 59),
+				},
+			},
+		},
+	}
+}
+
+func (m *WLANScheduleResourceModel) toUnifiStruct(ctx context.Context) (unifi.WLANScheduleWithDuration, diag.Diagnostics) {
+	var days []string
+	diags := m.Days.ElementsAs(ctx, &days, false)
+
+	// Keep the days in week order so the controller representation is stable.
+	slices.SortFunc(days, func(a, b string) int {
+		return slices.Index(wlanScheduleDays, a) - slices.Index(wlanScheduleDays, b)
+	})
+
+	return unifi.WLANScheduleWithDuration{
+		DurationMinutes: utils.IntPtrValue(m.DurationMinutes.ValueInt32Pointer()),
+		StartDaysOfWeek: &days,
+		StartHour:       utils.IntPtrValue(m.StartHour.ValueInt32Pointer()),
+		StartMinute:     utils.IntPtrValue(m.StartMinute.ValueInt32Pointer()),
+	}, diags
+}
+
+func newWLANScheduleResourceModel(ctx context.Context, schedule unifi.WLANScheduleWithDuration) (WLANScheduleResourceModel, diag.Diagnostics) {
+	var days []string
+	if schedule.StartDaysOfWeek != nil {
+		days = *schedule.StartDaysOfWeek
+	}
+
+	daysValue, diags := types.SetValueFrom(ctx, types.StringType, days)
+
+	startMinute := types.Int32Value(0)
+	if schedule.StartMinute != nil {
+		startMinute = types.Int32Value(int32(*schedule.StartMinute))
+	}
+
+	return WLANScheduleResourceModel{
+		Days:            daysValue,
+		DurationMinutes: types.Int32PointerValue(utils.Int32PtrValue(schedule.DurationMinutes)),
+		StartHour:       types.Int32PointerValue(utils.Int32PtrValue(schedule.StartHour)),
+		StartMinute:     startMinute,
+	}, diags
+}
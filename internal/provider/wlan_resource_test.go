@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccWLANResource_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWLANConfig(`security = "wpa2"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccWLANConfig(`
+  security   = "open"
+  passphrase = "supersecret"
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccWLANConfig(`
+  security = "open"
+  bands    = ["4g"]
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestAccWLANResource_Simple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWLANConfig(`
+  security   = "wpa2"
+  passphrase = "supersecret"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "name", "Test WLAN"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "security", "wpa2"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "bands.#", "2"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "hide_ssid", "false"),
+					resource.TestCheckResourceAttrSet("unifi_wlan.test", "user_group_id"),
+					resource.TestCheckNoResourceAttr("unifi_wlan.test", "mac_filter"),
+					resource.TestCheckNoResourceAttr("unifi_wlan.test", "schedule"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unifi_wlan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWLANConfig(`
+  security         = "wpa2-wpa3"
+  passphrase       = "supersecret"
+  bands            = ["5g"]
+  hide_ssid        = true
+  client_isolation = true
+  fast_roaming     = true
+
+  minimum_data_rates = {
+    rate_5g_kbps = 12000
+  }
+
+  mac_filter = {
+    policy        = "deny"
+    mac_addresses = ["00:27:22:00:00:10"]
+  }
+
+  schedule = [
+    {
+      days             = ["sat", "sun"]
+      start_hour       = 9
+      start_minute     = 30
+      duration_minutes = 600
+    },
+  ]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unifi_wlan.test", "security", "wpa2-wpa3"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "bands.#", "1"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "hide_ssid", "true"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "client_isolation", "true"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "fast_roaming", "true"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "minimum_data_rates.rate_5g_kbps", "12000"),
+					resource.TestCheckNoResourceAttr("unifi_wlan.test", "minimum_data_rates.rate_2g_kbps"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "mac_filter.policy", "deny"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "schedule.0.days.#", "2"),
+					resource.TestCheckResourceAttr("unifi_wlan.test", "schedule.0.start_minute", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWLANConfig(settings string) string {
+	return fmt.Sprintf(`
+provider "unifi" {}
+resource "unifi_network" "test" {
+  name    = "Test WLAN Network"
+  subnet  = "10.0.70.1/24"
+  vlan_id = 70
+}
+
+resource "unifi_wlan" "test" {
+  name       = "Test WLAN"
+  network_id = unifi_network.test.id
+  %s
+}
+`, settings)
+}